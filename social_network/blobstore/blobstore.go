@@ -0,0 +1,57 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+type TBlobInfo struct {
+	Size int64
+}
+
+// Storage for binary objects addressed by slash-separated keys like `attachments/42/photo`.
+type BlobStore interface {
+	// Size may be -1 if it is not known in advance
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Returns ErrNotFound if there is no such blob
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Stat(ctx context.Context, key string) (*TBlobInfo, error)
+	// Deleting a missing blob is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+type TConfig struct {
+	Kind        string
+	LocalRoot   string
+	S3Endpoint  string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+	S3UseSSL    bool
+}
+
+func (c *TConfig) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.Kind, "blob_store", "local", "blob storage backend: `local` or `s3`")
+	fs.StringVar(&c.LocalRoot, "blob_root", "./blobs", "root directory of the local blob storage")
+	fs.StringVar(&c.S3Endpoint, "s3_endpoint", "minio:9000", "S3-compatible storage endpoint")
+	fs.StringVar(&c.S3Bucket, "s3_bucket", "attachments", "S3 bucket for blobs")
+	fs.StringVar(&c.S3AccessKey, "s3_access_key", os.Getenv("S3_ACCESS_KEY"), "S3 access key, $S3_ACCESS_KEY by default")
+	fs.StringVar(&c.S3SecretKey, "s3_secret_key", os.Getenv("S3_SECRET_KEY"), "S3 secret key, $S3_SECRET_KEY by default")
+	fs.BoolVar(&c.S3UseSSL, "s3_ssl", false, "use https for S3")
+}
+
+func New(ctx context.Context, config TConfig) (BlobStore, error) {
+	switch config.Kind {
+	case "local":
+		return NewLocalBlobStore(config.LocalRoot)
+	case "s3":
+		return NewS3BlobStore(ctx, config)
+	}
+	return nil, fmt.Errorf("unknown blob store %q", config.Kind)
+}
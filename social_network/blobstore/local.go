@@ -0,0 +1,105 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+type TLocalBlobStore struct {
+	root string
+}
+
+func NewLocalBlobStore(root string) (*TLocalBlobStore, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(absRoot, 0o755); err != nil {
+		return nil, err
+	}
+	return &TLocalBlobStore{root: absRoot}, nil
+}
+
+// Maps the key onto a path inside the root, rejecting keys that try to escape it.
+func (s *TLocalBlobStore) path(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" || cleaned != "/"+key || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
+
+func (s *TLocalBlobStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	blobPath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(blobPath), 0o755); err != nil {
+		return err
+	}
+
+	// write next to the target and rename, so readers never see a partial blob
+	tmp, err := os.CreateTemp(filepath.Dir(blobPath), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if size >= 0 && written != size {
+		return fmt.Errorf("expected %d bytes, got %d", size, written)
+	}
+	return os.Rename(tmp.Name(), blobPath)
+}
+
+func (s *TLocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	blobPath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(blobPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
+
+func (s *TLocalBlobStore) Stat(ctx context.Context, key string) (*TBlobInfo, error) {
+	blobPath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(blobPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &TBlobInfo{Size: info.Size()}, nil
+}
+
+func (s *TLocalBlobStore) Delete(ctx context.Context, key string) error {
+	blobPath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(blobPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,103 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := NewLocalBlobStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testBlobStore(t, store)
+
+	if _, err := os.Stat(filepath.Join(root, "attachments", "1", "photo")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("deleted blob is still on disk: %v", err)
+	}
+	if err := store.Put(ctx, "attachments/1/short", strings.NewReader("abc"), 4, "text/plain"); err == nil {
+		t.Error("blob shorter than its size was stored")
+	}
+	if _, err := store.Stat(ctx, "attachments/1/short"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("partial blob is visible: %v", err)
+	}
+}
+
+func TestLocalBlobStoreRejectsEscapingKeys(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := NewLocalBlobStore(filepath.Join(root, "blobs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"", "/", "../outside", "attachments/../../outside", "/attachments/1", "attachments//1", "attachments\\1"} {
+		if err := store.Put(ctx, key, strings.NewReader("x"), 1, "text/plain"); err == nil {
+			t.Errorf("key %q was accepted", key)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "outside")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("blob was written outside of the root: %v", err)
+	}
+}
+
+// Checks the BlobStore contract on a store without blobs.
+func testBlobStore(t *testing.T, store BlobStore) {
+	ctx := context.Background()
+	const key = "attachments/1/photo"
+	const content = "not really a photo"
+
+	if _, err := store.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get of a missing blob: %v", err)
+	}
+	if _, err := store.Stat(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Stat of a missing blob: %v", err)
+	}
+
+	if err := store.Put(ctx, key, strings.NewReader(content), int64(len(content)), "image/jpeg"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	info, err := store.Stat(ctx, key)
+	if err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+	if info.Size != int64(len(content)) {
+		t.Errorf("Stat size is %d, expected %d", info.Size, len(content))
+	}
+	blob, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	data, err := io.ReadAll(blob)
+	blob.Close()
+	if err != nil {
+		t.Fatalf("reading blob: %v", err)
+	}
+	if string(data) != content {
+		t.Errorf("Get returned %q, expected %q", data, content)
+	}
+
+	// blobs of unknown size are stored as well
+	if err := store.Put(ctx, key, strings.NewReader("replaced"), -1, "image/jpeg"); err != nil {
+		t.Fatalf("Put of unknown size: %v", err)
+	}
+	if info, err := store.Stat(ctx, key); err != nil || info.Size != int64(len("replaced")) {
+		t.Errorf("Stat after replacing: %v %v", info, err)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := store.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get of a deleted blob: %v", err)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Errorf("Delete of a missing blob: %v", err)
+	}
+}
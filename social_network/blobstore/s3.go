@@ -0,0 +1,72 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// Works with AWS S3 as well as with MinIO and other S3-compatible storages.
+type TS3BlobStore struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewS3BlobStore(ctx context.Context, config TConfig) (*TS3BlobStore, error) {
+	client, err := minio.New(config.S3Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.S3AccessKey, config.S3SecretKey, ""),
+		Secure: config.S3UseSSL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := client.BucketExists(ctx, config.S3Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bucket %v: %v", config.S3Bucket, err)
+	}
+	if !exists {
+		err = client.MakeBucket(ctx, config.S3Bucket, minio.MakeBucketOptions{})
+		if err != nil && minio.ToErrorResponse(err).Code != "BucketAlreadyOwnedByYou" {
+			return nil, fmt.Errorf("failed to create bucket %v: %v", config.S3Bucket, err)
+		}
+	}
+
+	return &TS3BlobStore{client: client, bucket: config.S3Bucket}, nil
+}
+
+func isNotFound(err error) bool {
+	code := minio.ToErrorResponse(err).Code
+	return code == "NoSuchKey" || code == "NotFound"
+}
+
+func (s *TS3BlobStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (s *TS3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	// GetObject is lazy, stat first to report missing blobs right away
+	if _, err := s.Stat(ctx, key); err != nil {
+		return nil, err
+	}
+	return s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+}
+
+func (s *TS3BlobStore) Stat(ctx context.Context, key string) (*TBlobInfo, error) {
+	info, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{})
+	if isNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &TBlobInfo{Size: info.Size}, nil
+}
+
+func (s *TS3BlobStore) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
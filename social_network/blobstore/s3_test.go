@@ -0,0 +1,198 @@
+package blobstore
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A MinIO-style S3 stub that keeps objects in memory. Only path-style requests of a single region are served.
+// Objects of unknown size are uploaded in parts, those are kept by upload id until the upload is completed.
+type s3Stub struct {
+	mu      sync.Mutex
+	buckets map[string]bool
+	objects map[string][]byte
+	uploads map[string]map[int][]byte
+}
+
+func newS3Stub() *s3Stub {
+	return &s3Stub{buckets: map[string]bool{}, objects: map[string][]byte{}, uploads: map[string]map[int][]byte{}}
+}
+
+func (s *s3Stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+		s3Error(w, r, http.StatusForbidden, "AccessDenied")
+		return
+	}
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if key == "" {
+		s.serveBucket(w, r, bucket)
+		return
+	}
+	if !s.buckets[bucket] {
+		s3Error(w, r, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	object := bucket + "/" + key
+	if query := r.URL.Query(); query.Has("uploads") || query.Has("uploadId") {
+		s.serveMultipart(w, r, bucket, key)
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		data, err := readS3Payload(r)
+		if err != nil {
+			s3Error(w, r, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		s.objects[object] = data
+		w.Header().Set("ETag", `"stub"`)
+	case http.MethodHead, http.MethodGet:
+		data, ok := s.objects[object]
+		if !ok {
+			s3Error(w, r, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", `"stub"`)
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	case http.MethodDelete:
+		delete(s.objects, object)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s3Error(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (s *s3Stub) serveMultipart(w http.ResponseWriter, r *http.Request, bucket string, key string) {
+	query := r.URL.Query()
+	uploadId := query.Get("uploadId")
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadId = strconv.Itoa(len(s.uploads) + 1)
+		s.uploads[uploadId] = map[int][]byte{}
+		fmt.Fprintf(w, `<InitiateMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>`, bucket, key, uploadId)
+	case s.uploads[uploadId] == nil:
+		s3Error(w, r, http.StatusNotFound, "NoSuchUpload")
+	case r.Method == http.MethodPut:
+		partNumber, err := strconv.Atoi(query.Get("partNumber"))
+		data, readErr := readS3Payload(r)
+		if err != nil || readErr != nil {
+			s3Error(w, r, http.StatusBadRequest, "InvalidPart")
+			return
+		}
+		s.uploads[uploadId][partNumber] = data
+		w.Header().Set("ETag", fmt.Sprintf(`"part%d"`, partNumber))
+	case r.Method == http.MethodPost:
+		parts := s.uploads[uploadId]
+		var data []byte
+		for partNumber := 1; parts[partNumber] != nil; partNumber++ {
+			data = append(data, parts[partNumber]...)
+		}
+		s.objects[bucket+"/"+key] = data
+		delete(s.uploads, uploadId)
+		fmt.Fprintf(w, `<CompleteMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><ETag>"stub"</ETag></CompleteMultipartUploadResult>`, bucket, key)
+	case r.Method == http.MethodDelete:
+		delete(s.uploads, uploadId)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s3Error(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (s *s3Stub) serveBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Query().Has("location"):
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></LocationConstraint>`)
+	case r.Method == http.MethodHead:
+		if !s.buckets[bucket] {
+			s3Error(w, r, http.StatusNotFound, "NoSuchBucket")
+		}
+	case r.Method == http.MethodPut:
+		if s.buckets[bucket] {
+			s3Error(w, r, http.StatusConflict, "BucketAlreadyOwnedByYou")
+			return
+		}
+		s.buckets[bucket] = true
+	default:
+		s3Error(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func s3Error(w http.ResponseWriter, r *http.Request, code int, s3Code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(code)
+	if r.Method != http.MethodHead {
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Resource>%s</Resource></Error>`, s3Code, r.URL.Path)
+	}
+}
+
+// Plain http uploads are signed chunk by chunk, the chunks are `<hex size>;chunk-signature=...\r\n<data>\r\n`.
+func readS3Payload(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+	var data []byte
+	body := bufio.NewReader(r.Body)
+	for {
+		header, err := body.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(header), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return data, nil
+		}
+		chunk := make([]byte, size+2)
+		if _, err := io.ReadFull(body, chunk); err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:size]...)
+	}
+}
+
+func TestS3BlobStore(t *testing.T) {
+	stub := newS3Stub()
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	config := TConfig{
+		Kind:        "s3",
+		S3Endpoint:  strings.TrimPrefix(server.URL, "http://"),
+		S3Bucket:    "attachments",
+		S3AccessKey: "access",
+		S3SecretKey: "secret",
+	}
+	store, err := NewS3BlobStore(context.Background(), config)
+	if err != nil {
+		t.Fatalf("NewS3BlobStore: %v", err)
+	}
+	if !stub.buckets["attachments"] {
+		t.Fatal("bucket was not created")
+	}
+	testBlobStore(t, store)
+
+	// the bucket is reused by the next replica
+	if _, err := NewS3BlobStore(context.Background(), config); err != nil {
+		t.Fatalf("NewS3BlobStore with an existing bucket: %v", err)
+	}
+}
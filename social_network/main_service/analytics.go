@@ -0,0 +1,51 @@
+package main
+
+import (
+	"net/http"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+// Serves the stats of the caller's posts. Takes the query parameters of the rankings: the best posts are ordered
+// by `metric` (likes by default) and `limit` of them are shown, the window is the last week unless `window` or
+// `from`/`to` say otherwise.
+func AuthorAnalyticsHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	query := r.URL.Query()
+	if query.Get("window") == "" && query.Get("from") == "" && query.Get("to") == "" {
+		query.Set("window", "week")
+	}
+	metric := query.Get("metric")
+	if metric == "" {
+		metric = "likes"
+	}
+	top, err := parseTopQuery(metric, query)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid analytics parameters: %v", err) {
+		return
+	}
+
+	pbRes, err := statsServiceClient.GetAuthorAnalytics(r.Context(), &pb.TGetAuthorAnalyticsRequest{
+		AuthorLogin:   login,
+		Window:        top.Window,
+		OrderBy:       top.OrderBy,
+		TopPostsLimit: top.Limit,
+	})
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to process request") {
+		return
+	}
+	// follows are known to post_service only, the growth is counted over the same window
+	pbRes.Followers, err = postServiceClient.GetFollowerGrowth(r.Context(), &pb.TGetFollowerGrowthRequest{
+		Login: login,
+		From:  pbRes.From,
+		To:    pbRes.To,
+	})
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to count followers") {
+		return
+	}
+	respondProto(w, pbRes)
+}
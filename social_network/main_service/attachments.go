@@ -0,0 +1,232 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"auth"
+	"better_errors"
+	"blobstore"
+	pb "proto"
+)
+
+var (
+	blobStore         blobstore.BlobStore
+	maxAttachmentSize int64
+)
+
+// Content types are sniffed from the file itself, whatever the client claims
+var allowedContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+	"text/plain":      true,
+}
+
+var errUnsupportedContentType = errors.New("unsupported content type")
+
+// http.DetectContentType never looks further than that
+const sniffLength = 512
+
+func detectContentType(head []byte) (string, error) {
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return "", err
+	}
+	if !allowedContentTypes[contentType] {
+		return "", fmt.Errorf("%w %v", errUnsupportedContentType, contentType)
+	}
+	return contentType, nil
+}
+
+func randomId() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+func newAttachmentKey(postId uint64) string {
+	return fmt.Sprintf("attachments/%d/%s", postId, randomId())
+}
+
+// Rejects uploads to someone else's post before anything is stored.
+func checkPostAuthor(ctx context.Context, postId uint64, login string) error {
+	pbRes, err := postServiceClient.GetPostById(ctx, &pb.TGetPostByIdRequest{PostId: postId, ViewerLogin: login})
+	if err != nil {
+		return err
+	}
+	if pbRes.Post.AuthorLogin != login {
+		return status.Errorf(codes.PermissionDenied, "you are not the author of this post")
+	}
+	return nil
+}
+
+// Sniffs the content type, stores the file and registers it in post_service, removing the blob if that fails.
+func storeAttachment(ctx context.Context, postId uint64, login string, fileName string, file io.Reader, size int64) (*pb.TAttachmentResponse, error) {
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	contentType, err := detectContentType(head[:n])
+	if err != nil {
+		return nil, err
+	}
+
+	key := newAttachmentKey(postId)
+	if err := blobStore.Put(ctx, key, io.MultiReader(bytes.NewReader(head[:n]), file), size, contentType); err != nil {
+		return nil, err
+	}
+	return addAttachment(ctx, &pb.TAddAttachmentRequest{
+		PostId:      postId,
+		AuthorLogin: login,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        uint64(size),
+		BlobKey:     key,
+	})
+}
+
+func addAttachment(ctx context.Context, pbReq *pb.TAddAttachmentRequest) (*pb.TAttachmentResponse, error) {
+	pbRes, err := postServiceClient.AddAttachment(ctx, pbReq)
+	if err != nil {
+		better_errors.CheckError(blobStore.Delete(ctx, pbReq.BlobKey), "failed to delete blob %v", pbReq.BlobKey)
+		return nil, err
+	}
+	return pbRes, nil
+}
+
+func httpCodeFromUpload(err error) int {
+	if errors.Is(err, errUnsupportedContentType) {
+		return http.StatusUnsupportedMediaType
+	}
+	return httpCodeFromGrpc(err, http.StatusInternalServerError)
+}
+
+func UploadAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	postId, err := parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+	err = checkPostAuthor(r.Context(), postId, login)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to upload attachment") {
+		return
+	}
+
+	// leave some room for multipart headers
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentSize+1<<20)
+	file, header, err := r.FormFile("file")
+	var maxBytesErr *http.MaxBytesError
+	if better_errors.CheckCustomHttp(errors.As(err, &maxBytesErr), w, http.StatusRequestEntityTooLarge, "attachments can not be larger than %d bytes", maxAttachmentSize) {
+		return
+	}
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "expected multipart form with a `file` field") {
+		return
+	}
+	defer file.Close()
+	defer r.MultipartForm.RemoveAll()
+	if better_errors.CheckCustomHttp(header.Size > maxAttachmentSize, w, http.StatusRequestEntityTooLarge, "attachments can not be larger than %d bytes", maxAttachmentSize) {
+		return
+	}
+
+	pbRes, err := storeAttachment(r.Context(), postId, login, header.Filename, file, header.Size)
+	if better_errors.CheckHttpError(err, w, httpCodeFromUpload(err), "failed to upload attachment") {
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	respondProto(w, pbRes)
+}
+
+func GetAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	attachmentId, err := parseUintVar(r, "attachment_id")
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid attachment id") {
+		return
+	}
+
+	pbRes, err := postServiceClient.GetAttachment(r.Context(), &pb.TGetAttachmentRequest{AttachmentId: attachmentId, ViewerLogin: login})
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to get attachment") {
+		return
+	}
+	attachment := pbRes.Attachment
+	blobKey, contentType, size := attachment.BlobKey, attachment.ContentType, attachment.Size
+
+	// the uploaded file of an image may carry EXIF metadata, so only its processed variants are served
+	if len(attachment.Variants) > 0 {
+		name := strings.ToUpper(r.URL.Query().Get("variant"))
+		if name == "" {
+			name = pb.TImageVariant_ORIGINAL.String()
+		}
+		variant := findImageVariant(attachment, name)
+		if better_errors.CheckCustomHttp(variant == nil, w, http.StatusBadRequest, "unknown variant %v", name) {
+			return
+		}
+		if better_errors.CheckCustomHttp(variant.State != pb.TImageVariant_READY, w, http.StatusConflict, "variant %v is %v", name, variant.State) {
+			return
+		}
+		blobKey, contentType, size = variant.BlobKey, variant.ContentType, variant.Size
+	}
+
+	blob, err := blobStore.Get(r.Context(), blobKey)
+	if better_errors.CheckCustomHttp(errors.Is(err, blobstore.ErrNotFound), w, http.StatusNotFound, "attachment file is missing") {
+		return
+	}
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to get attachment") {
+		return
+	}
+	defer blob.Close()
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.FormatUint(size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": attachment.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	_, err = io.Copy(w, blob)
+	better_errors.CheckError(err, "failed to send attachment %d", attachmentId)
+}
+
+func findImageVariant(attachment *pb.TAttachment, name string) *pb.TImageVariant {
+	for _, variant := range attachment.Variants {
+		if variant.Name.String() == name {
+			return variant
+		}
+	}
+	return nil
+}
+
+func DeleteAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	attachmentId, err := parseUintVar(r, "attachment_id")
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid attachment id") {
+		return
+	}
+
+	pbRes, err := postServiceClient.DeleteAttachment(r.Context(), &pb.TDeleteAttachmentRequest{AttachmentId: attachmentId, AuthorLogin: login})
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to delete attachment") {
+		return
+	}
+	respondProto(w, pbRes)
+}
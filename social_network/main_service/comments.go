@@ -0,0 +1,152 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+func CreateCommentHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbReq := &pb.TCreateCommentRequest{}
+	body, _ := io.ReadAll(r.Body)
+	err = protojson.Unmarshal(body, pbReq)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "bad request") {
+		return
+	}
+	pbReq.AuthorLogin = login // set login from token
+	pbReq.PostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+
+	pbRes, err := postServiceClient.CreateComment(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to create comment") {
+		return
+	}
+	respondProto(w, pbRes)
+}
+
+func ListCommentsHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbReq := &pb.TListCommentsRequest{ViewerLogin: login}
+	pbReq.PostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+	query := r.URL.Query()
+	if parent := query.Get("parent"); parent != "" {
+		pbReq.ParentId, err = strconv.ParseUint(parent, 10, 64)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid parent comment id %v", parent) {
+			return
+		}
+	}
+	switch order := query.Get("order"); order {
+	case "", "newest":
+		pbReq.Order = pb.TListCommentsRequest_NEWEST
+	case "oldest":
+		pbReq.Order = pb.TListCommentsRequest_OLDEST
+	case "top":
+		pbReq.Order = pb.TListCommentsRequest_TOP
+	default:
+		better_errors.CheckCustomHttp(true, w, http.StatusBadRequest, "order should be `newest`, `oldest` or `top`")
+		return
+	}
+	if limit := query.Get("limit"); limit != "" {
+		parsedLimit, err := strconv.ParseUint(limit, 10, 32)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid limit %v", limit) {
+			return
+		}
+		pbReq.Limit = uint32(parsedLimit)
+	}
+	pbReq.Cursor = query.Get("cursor")
+
+	pbRes, err := postServiceClient.ListComments(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to list comments") {
+		return
+	}
+	respondProto(w, pbRes)
+}
+
+func UpdateCommentHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbReq := &pb.TUpdateCommentRequest{}
+	body, _ := io.ReadAll(r.Body)
+	err = protojson.Unmarshal(body, pbReq)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "bad request") {
+		return
+	}
+	pbReq.AuthorLogin = login // set login from token
+	pbReq.CommentId, err = parseUintVar(r, "comment_id")
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid comment id") {
+		return
+	}
+
+	pbRes, err := postServiceClient.UpdateComment(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusBadRequest), "failed to update comment") {
+		return
+	}
+	respondProto(w, pbRes)
+}
+
+func DeleteCommentHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbReq := &pb.TDeleteCommentRequest{}
+	pbReq.AuthorLogin = login // set login from token
+	pbReq.CommentId, err = parseUintVar(r, "comment_id")
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid comment id") {
+		return
+	}
+
+	_, err = postServiceClient.DeleteComment(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusBadRequest), "failed to delete comment") {
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// PUT likes the comment, DELETE takes the like back.
+func LikeCommentHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbReq := &pb.TLikeCommentRequest{Login: login}
+	pbReq.CommentId, err = parseUintVar(r, "comment_id")
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid comment id") {
+		return
+	}
+
+	if r.Method == http.MethodDelete {
+		_, err = postServiceClient.UnlikeComment(r.Context(), pbReq)
+	} else {
+		_, err = postServiceClient.LikeComment(r.Context(), pbReq)
+	}
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to like comment") {
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+func ListDraftsHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbRes, err := postServiceClient.ListDrafts(r.Context(), &pb.TListDraftsRequest{AuthorLogin: login})
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to list drafts") {
+		return
+	}
+	respondProto(w, pbRes)
+}
+
+// Publishes a draft right away, or schedules it if the body has a future `PublishAt`.
+func PublishPostHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbReq := &pb.TPublishPostRequest{}
+	body, _ := io.ReadAll(r.Body)
+	if len(body) > 0 {
+		err = protojson.Unmarshal(body, pbReq)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "bad request") {
+			return
+		}
+	}
+	pbReq.AuthorLogin = login // set login from token
+	pbReq.PostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+
+	pbRes, err := postServiceClient.PublishPost(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to publish post") {
+		return
+	}
+	respondProto(w, pbRes)
+}
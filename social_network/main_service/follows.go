@@ -0,0 +1,51 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+// PUT follows the user, DELETE unfollows them. Followers see posts of the followee that are visible to followers only.
+func FollowUserHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbReq := &pb.TFollowRequest{Follower: login, Followee: mux.Vars(r)["login"]}
+	if r.Method == http.MethodDelete {
+		_, err = postServiceClient.Unfollow(r.Context(), pbReq)
+	} else {
+		var exists int64
+		exists, err = redisClient.Exists(r.Context(), pbReq.Followee).Result()
+		if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to follow user") {
+			return
+		}
+		if better_errors.CheckCustomHttp(exists == 0, w, http.StatusNotFound, "user not found") {
+			return
+		}
+		_, err = postServiceClient.Follow(r.Context(), pbReq)
+	}
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to follow user") {
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func ListFollowingHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbRes, err := postServiceClient.ListFollowing(r.Context(), &pb.TListFollowingRequest{Login: login})
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to list followed users") {
+		return
+	}
+	respondProto(w, pbRes)
+}
@@ -1,27 +1,35 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"log"
 	"net/http"
 	"path/filepath"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/IBM/sarama"
 	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/mux"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"auth"
 	"better_errors"
+	"blobstore"
 	pb "proto"
 )
 
@@ -163,12 +171,13 @@ func CreatePostHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	pbReq.AuthorLogin = login // set login from token
-	pbRes, err := postServiceClient.CreatePost(r.Context(), &pbReq)
-	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to create post") {
+	pbReq.Mentions, err = resolveMentions(r.Context(), pbReq.Content)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to resolve mentions") {
 		return
 	}
-	_, err = statsServiceClient.AddPost(r.Context(), &pb.TAddPostRequest{PostId: *pbRes.PostId, AuthorLogin: login})
-	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to add post") {
+	// stats_service learns about the post from post_service once it is published
+	pbRes, err := postServiceClient.CreatePost(r.Context(), &pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to create post") {
 		return
 	}
 	resBody, err := protojson.Marshal(pbRes)
@@ -196,12 +205,96 @@ func UpdatePostHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	pbReq.AuthorLogin = login // set login from token
+	pbReq.Mentions, err = resolveMentions(r.Context(), pbReq.Content)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to resolve mentions") {
+		return
+	}
 
-	_, err = postServiceClient.UpdatePost(r.Context(), &pbReq)
-	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "failed to update post") {
+	// If-Match takes precedence over the version from the body
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		pbReq.ExpectedVersion, err = parseETag(ifMatch)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid If-Match header") {
+			return
+		}
+	}
+	if better_errors.CheckCustomHttp(pbReq.ExpectedVersion == 0, w, http.StatusPreconditionRequired, "ExpectedVersion or If-Match is required") {
 		return
 	}
-	w.WriteHeader(http.StatusOK)
+
+	pbRes, err := postServiceClient.UpdatePost(r.Context(), &pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusBadRequest), "failed to update post") {
+		return
+	}
+	w.Header().Set("ETag", postETag(pbRes.Version))
+	respondProto(w, pbRes)
+}
+
+// Applies a JSON merge patch (RFC 7396) to the post: only keys present in the body are updated, null clears the field.
+func PatchPostHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbReq := pb.TUpdatePostRequest{UpdateMask: &fieldmaskpb.FieldMask{}}
+	pbReq.AuthorLogin = login // set login from token
+	pbReq.PostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+	pbReq.ExpectedVersion, err = parseETag(r.Header.Get("If-Match"))
+	if better_errors.CheckHttpError(err, w, http.StatusPreconditionRequired, "valid If-Match header is required") {
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	err = json.NewDecoder(r.Body).Decode(&patch)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "merge patch must be a json object") {
+		return
+	}
+	for field, value := range patch {
+		var target *string
+		var visibility string
+		switch field {
+		case "Title":
+			target = &pbReq.Title
+		case "Content":
+			target = &pbReq.Content
+		case "Visibility":
+			target = &visibility
+		}
+		if target != nil && string(value) != "null" {
+			err = json.Unmarshal(value, target)
+			if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "field %v must be a string", field) {
+				return
+			}
+		}
+		// null resets visibility to PUBLIC
+		if visibility != "" {
+			parsed, ok := pb.TPost_EVisibility_value[visibility]
+			if better_errors.CheckCustomHttp(!ok, w, http.StatusBadRequest, "unknown visibility %v", visibility) {
+				return
+			}
+			pbReq.Visibility = pb.TPost_EVisibility(parsed)
+		}
+		// unknown fields are left in the mask for post_service to reject
+		pbReq.UpdateMask.Paths = append(pbReq.UpdateMask.Paths, field)
+	}
+	if better_errors.CheckCustomHttp(len(pbReq.UpdateMask.Paths) == 0, w, http.StatusBadRequest, "empty merge patch") {
+		return
+	}
+	// mentions are only updated together with the content
+	pbReq.Mentions, err = resolveMentions(r.Context(), pbReq.Content)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to resolve mentions") {
+		return
+	}
+
+	pbRes, err := postServiceClient.UpdatePost(r.Context(), &pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusBadRequest), "failed to update post") {
+		return
+	}
+	w.Header().Set("ETag", postETag(pbRes.Version))
+	respondProto(w, pbRes)
 }
 
 func DeletePostHandler(w http.ResponseWriter, r *http.Request) {
@@ -226,43 +319,50 @@ func DeletePostHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func GetPostByIdHandler(w http.ResponseWriter, r *http.Request) {
-	_, err := auth.VerifyToken(r, authHandler)
+	login, err := auth.VerifyToken(r, authHandler)
 	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
 		return
 	}
 
 	// Get post
-	pbReq := pb.TGetPostByIdRequest{}
+	pbReq := pb.TGetPostByIdRequest{ViewerLogin: login}
 	pbReq.PostId, err = parsePostId(r)
 	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
 		return
 	}
 
-	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "failed to delete post") {
+	pbRes, err := postServiceClient.GetPostById(r.Context(), &pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to process request") {
 		return
 	}
-	pbRes, err := postServiceClient.GetPostById(r.Context(), &pbReq)
-	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to process request") {
+
+	err = markLikedByViewer(r.Context(), login, []*pb.TPost{pbRes.Post})
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to get likes") {
 		return
 	}
-	resBody, err := protojson.Marshal(pbRes)
+
+	etag, err := postResponseETag(pbRes.GetPost().GetVersion(), pbRes)
 	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to marshal response") {
 		return
 	}
-	_, err = w.Write(resBody)
-	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to respond properly") {
-		return
+	w.Header().Set("ETag", etag)
+	for _, ifNoneMatch := range strings.Split(r.Header.Get("If-None-Match"), ",") {
+		if strings.TrimPrefix(strings.TrimSpace(ifNoneMatch), "W/") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
 	}
+	respondProto(w, pbRes)
 }
 
 func GetPostsOnPageHandler(w http.ResponseWriter, r *http.Request) {
-	_, err := auth.VerifyToken(r, authHandler)
+	login, err := auth.VerifyToken(r, authHandler)
 	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
 		return
 	}
 
 	// Get posts on page
-	pbReq := pb.TGetPostsOnPageRequest{}
+	pbReq := pb.TGetPostsOnPageRequest{ViewerLogin: login}
 	vars := mux.Vars(r)
 	pageIdStr, ok := vars["page_id"]
 	if better_errors.CheckCustomHttp(!ok, w, http.StatusBadRequest, "invalid page_id") {
@@ -281,6 +381,10 @@ func GetPostsOnPageHandler(w http.ResponseWriter, r *http.Request) {
 	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to process request") {
 		return
 	}
+	err = markLikedByViewer(r.Context(), login, pbRes.Posts)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to get likes") {
+		return
+	}
 	resBody, err := protojson.Marshal(pbRes)
 	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to marshal response") {
 		return
@@ -291,6 +395,64 @@ func GetPostsOnPageHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func parseUintVar(r *http.Request, name string) (uint64, error) {
+	vars := mux.Vars(r)
+	valueStr, ok := vars[name]
+	if !ok {
+		return 0, fmt.Errorf("expected uint64 `%v`, but nothing found", name)
+	}
+	return strconv.ParseUint(valueStr, 10, 64)
+}
+
+// Maps gRPC status of the downstream call onto HTTP, falling back to the given code.
+func httpCodeFromGrpc(err error, fallback int) int {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Aborted:
+		return http.StatusPreconditionFailed
+	case codes.FailedPrecondition, codes.AlreadyExists:
+		return http.StatusConflict
+	}
+	return fallback
+}
+
+func respondProto(w http.ResponseWriter, pbRes proto.Message) {
+	resBody, err := protojson.Marshal(pbRes)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to marshal response") {
+		return
+	}
+	_, err = w.Write(resBody)
+	better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to respond properly")
+}
+
+// Post ETags are its version, so they change on every edit.
+func postETag(version uint64) string {
+	return fmt.Sprintf("\"%d\"", version)
+}
+
+// The ETag of a post as it is shown to the viewer. Attachments, polls, references and likes change without a new
+// version, so a hash of the response follows the version. If-Match only compares the version.
+func postResponseETag(version uint64, pbRes proto.Message) (string, error) {
+	serialized, err := proto.MarshalOptions{Deterministic: true}.Marshal(pbRes)
+	if err != nil {
+		return "", err
+	}
+	hash := fnv.New64a()
+	hash.Write(serialized)
+	return fmt.Sprintf("\"%d-%016x\"", version, hash.Sum64()), nil
+}
+
+func parseETag(etag string) (uint64, error) {
+	etag = strings.Trim(strings.TrimPrefix(strings.TrimSpace(etag), "W/"), "\"")
+	version, _, _ := strings.Cut(etag, "-")
+	return strconv.ParseUint(version, 10, 64)
+}
+
 func parsePostId(r *http.Request) (uint64, error) {
 	vars := mux.Vars(r)
 	postIdStr, ok := vars["post_id"]
@@ -300,8 +462,24 @@ func parsePostId(r *http.Request) (uint64, error) {
 	return strconv.ParseUint(postIdStr, 10, 64)
 }
 
+// Stats of a post are only shown and counted for viewers who can open it. post_service reports hidden posts
+// as missing, so that stats do not leak their existence either.
+func checkPostVisible(ctx context.Context, postId uint64, login string) error {
+	_, err := postServiceClient.GetPostById(ctx, &pb.TGetPostByIdRequest{PostId: postId, ViewerLogin: login})
+	return err
+}
+
+// Stats messages are keyed by post id, so that the events of a post go to one partition of StatsTopic in order.
+func newStatsMessage(postId uint64, serializedStats []byte) *sarama.ProducerMessage {
+	return &sarama.ProducerMessage{
+		Topic: "StatsTopic",
+		Key:   sarama.StringEncoder(strconv.FormatUint(postId, 10)),
+		Value: sarama.ByteEncoder(serializedStats),
+	}
+}
+
 func ViewPostByIdHandler(w http.ResponseWriter, r *http.Request) {
-	_, err := auth.VerifyToken(r, authHandler)
+	login, err := auth.VerifyToken(r, authHandler)
 	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
 		return
 	}
@@ -309,13 +487,17 @@ func ViewPostByIdHandler(w http.ResponseWriter, r *http.Request) {
 	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
 		return
 	}
-	postStats := pb.TPostStats{PostId: postId, Liked: 0, Viewed: 1}
+	err = checkPostVisible(r.Context(), postId, login)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to get post") {
+		return
+	}
+	postStats := pb.TPostStats{PostId: postId, Liked: 0, Viewed: 1, Login: login}
 	serializedStats, err := proto.Marshal(&postStats)
 	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to serialize post stats message") {
 		return
 	}
 
-	message := &sarama.ProducerMessage{Topic: "StatsTopic", Value: sarama.ByteEncoder(serializedStats)}
+	message := newStatsMessage(postId, serializedStats)
 
 	select {
 	case kafkaProducer.Input() <- message:
@@ -325,8 +507,9 @@ func ViewPostByIdHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// PUT likes the post and DELETE unlikes it, both are idempotent since stats_service keeps one like per user.
 func LikePostByIdHandler(w http.ResponseWriter, r *http.Request) {
-	_, err := auth.VerifyToken(r, authHandler)
+	login, err := auth.VerifyToken(r, authHandler)
 	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
 		return
 	}
@@ -334,13 +517,22 @@ func LikePostByIdHandler(w http.ResponseWriter, r *http.Request) {
 	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
 		return
 	}
-	postStats := pb.TPostStats{PostId: postId, Liked: 1, Viewed: 0}
+	err = checkPostVisible(r.Context(), postId, login)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to get post") {
+		return
+	}
+	postStats := pb.TPostStats{PostId: postId, Login: login, Timestamp: timestamppb.Now()}
+	if r.Method == http.MethodDelete {
+		postStats.Unliked = 1
+	} else {
+		postStats.Liked = 1
+	}
 	serializedStats, err := proto.Marshal(&postStats)
 	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to serialize post stats message") {
 		return
 	}
 
-	message := &sarama.ProducerMessage{Topic: "StatsTopic", Value: sarama.ByteEncoder(serializedStats)}
+	message := newStatsMessage(postId, serializedStats)
 
 	select {
 	case kafkaProducer.Input() <- message:
@@ -350,66 +542,40 @@ func LikePostByIdHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func PostStatsHandler(w http.ResponseWriter, r *http.Request) {
-	_, err := auth.VerifyToken(r, authHandler)
-	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
-		return
+// Sets LikedByViewer of the posts, likes are known to stats_service only.
+func markLikedByViewer(ctx context.Context, login string, posts []*pb.TPost) error {
+	pbReq := &pb.TGetViewerLikesRequest{ViewerLogin: login}
+	for _, post := range posts {
+		pbReq.PostIds = append(pbReq.PostIds, post.PostId)
 	}
-	pbReq := &pb.TGetPostStatsRequest{}
-	pbReq.PostId, err = parsePostId(r)
-	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
-		return
+	if len(pbReq.PostIds) == 0 {
+		return nil
 	}
-	pbRes, err := statsServiceClient.GetPostStats(r.Context(), pbReq)
-	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to process request") {
-		return
-	}
-	resBody, err := protojson.Marshal(pbRes)
-	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to marshal response") {
-		return
+	pbRes, err := statsServiceClient.GetViewerLikes(ctx, pbReq)
+	if err != nil {
+		return err
 	}
-	_, err = w.Write(resBody)
-	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to respond properly") {
-		return
+	for _, post := range posts {
+		post.LikedByViewer = slices.Contains(pbRes.LikedPostIds, post.PostId)
 	}
+	return nil
 }
 
-func TopPostsHandler(w http.ResponseWriter, r *http.Request) {
-	_, err := auth.VerifyToken(r, authHandler)
+func PostStatsHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
 	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
 		return
 	}
-	vars := mux.Vars(r)
-	sortBy, ok := vars["type"]
-	ok = ok && (sortBy == "likes" || sortBy == "views")
-	if better_errors.CheckCustomHttp(!ok, w, http.StatusBadRequest, "Should sort by `liks` or `views`") {
-		return
-	}
-
-	pbRes, err := statsServiceClient.GetTopPosts(r.Context(), &pb.TGetTopPostsRequest{OrderBy: sortBy})
-	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to process request") {
-		return
-	}
-	resBody, err := protojson.Marshal(pbRes)
-	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to marshal response") {
-		return
-	}
-	_, err = w.Write(resBody)
-	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to respond properly") {
-		return
-	}
-}
-
-func TopAuthorsHandler(w http.ResponseWriter, r *http.Request) {
-	_, err := auth.VerifyToken(r, authHandler)
-	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+	pbReq := &pb.TGetPostStatsRequest{}
+	pbReq.PostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
 		return
 	}
-
-	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to process request") {
+	err = checkPostVisible(r.Context(), pbReq.PostId, login)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to get post") {
 		return
 	}
-	pbRes, err := statsServiceClient.GetTopAuthors(r.Context(), &emptypb.Empty{})
+	pbRes, err := statsServiceClient.GetPostStats(r.Context(), pbReq)
 	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to process request") {
 		return
 	}
@@ -428,6 +594,9 @@ func main() {
 	publicKeyPath := flag.String("public", "", "path to JWT public key file")
 	port := flag.Int("port", 8000, "http server port")
 	redisPort := flag.Int("redis_port", 6379, "redis port")
+	flag.Int64Var(&maxAttachmentSize, "max_attachment_size", 20<<20, "max size of a post attachment in bytes")
+	var blobConfig blobstore.TConfig
+	blobConfig.RegisterFlags(flag.CommandLine)
 	flag.Parse()
 
 	better_errors.CheckCustomFatal(port == nil, "invalid port")
@@ -447,6 +616,9 @@ func main() {
 	kafkaProducer, err = sarama.NewAsyncProducer([]string{"kafka:9092"}, nil)
 	better_errors.CheckErrorFatal(err, "failed to create kafka producer")
 
+	blobStore, err = blobstore.New(context.Background(), blobConfig)
+	better_errors.CheckErrorFatal(err, "failed to create blob store")
+
 	authHandler, err = auth.NewAuthHandler(privateKeyAbsPath, publicKeyAbsPath)
 	better_errors.CheckErrorFatal(err, "failed to create auth handler")
 
@@ -470,10 +642,42 @@ func main() {
 	r.HandleFunc("/posts/single/{post_id}", GetPostByIdHandler).Methods("GET")
 	r.HandleFunc("/posts/page/{page_id}", GetPostsOnPageHandler).Methods("GET")
 	r.HandleFunc("/posts/viewed/{post_id}", ViewPostByIdHandler).Methods("PUT")
-	r.HandleFunc("/posts/liked/{post_id}", LikePostByIdHandler).Methods("PUT")
+	r.HandleFunc("/posts/liked/{post_id}", LikePostByIdHandler).Methods("PUT", "DELETE")
 	r.HandleFunc("/posts/stats/{post_id}", PostStatsHandler).Methods("GET")
+	r.HandleFunc("/posts/stats/{post_id}/timeseries", PostTimeSeriesHandler).Methods("GET")
 	r.HandleFunc("/posts/top/{type}", TopPostsHandler).Methods("GET")
 	r.HandleFunc("/users/top", TopAuthorsHandler).Methods("GET")
+	r.HandleFunc("/users/me/analytics", AuthorAnalyticsHandler).Methods("GET")
+	r.HandleFunc("/users/followed/{login}", FollowUserHandler).Methods("PUT", "DELETE")
+	r.HandleFunc("/users/following", ListFollowingHandler).Methods("GET")
+	r.HandleFunc("/users/notifications", ListNotificationsHandler).Methods("GET")
+	r.HandleFunc("/users/mentions", ListMentionsHandler).Methods("GET")
+	r.HandleFunc("/posts/{post_id:[0-9]+}/repost", RepostHandler).Methods("POST")
+	r.HandleFunc("/posts/{post_id:[0-9]+}/poll", PollResultsHandler).Methods("GET")
+	r.HandleFunc("/posts/{post_id:[0-9]+}/poll/vote", VotePollHandler).Methods("POST")
+	r.HandleFunc("/posts/{post_id:[0-9]+}", PatchPostHandler).Methods("PATCH")
+	r.HandleFunc("/posts/trash", ListTrashHandler).Methods("GET")
+	r.HandleFunc("/posts/drafts", ListDraftsHandler).Methods("GET")
+	r.HandleFunc("/posts/{post_id:[0-9]+}/publish", PublishPostHandler).Methods("POST")
+	r.HandleFunc("/posts/search", SearchPostsHandler).Methods("GET")
+	r.HandleFunc("/posts/{post_id:[0-9]+}/comments", CreateCommentHandler).Methods("POST")
+	r.HandleFunc("/posts/{post_id:[0-9]+}/comments", ListCommentsHandler).Methods("GET")
+	r.HandleFunc("/comments/{comment_id}", UpdateCommentHandler).Methods("PUT")
+	r.HandleFunc("/comments/{comment_id}", DeleteCommentHandler).Methods("DELETE")
+	r.HandleFunc("/comments/liked/{comment_id}", LikeCommentHandler).Methods("PUT", "DELETE")
+	r.HandleFunc("/posts/{post_id:[0-9]+}/restore", RestorePostHandler).Methods("POST")
+	r.HandleFunc("/tags/trending", TrendingTagsHandler).Methods("GET")
+	r.HandleFunc("/tags/{tag}/posts", GetPostsByTagHandler).Methods("GET")
+	r.HandleFunc("/posts/revisions/{post_id}", ListPostRevisionsHandler).Methods("GET")
+	r.HandleFunc("/posts/revisions/{post_id}/diff", DiffPostRevisionsHandler).Methods("GET")
+	r.HandleFunc("/posts/revisions/{post_id}/{revision_id:[0-9]+}", GetPostRevisionHandler).Methods("GET")
+	r.HandleFunc("/posts/revisions/{post_id}/{revision_id:[0-9]+}/restore", RestorePostRevisionHandler).Methods("POST")
+	r.HandleFunc("/posts/{post_id:[0-9]+}/attachments", UploadAttachmentHandler).Methods("POST")
+	r.HandleFunc("/attachments/{attachment_id}", GetAttachmentHandler).Methods("GET")
+	r.HandleFunc("/attachments/{attachment_id}", DeleteAttachmentHandler).Methods("DELETE")
+	r.HandleFunc("/uploads", CreateUploadHandler).Methods("POST")
+	r.HandleFunc("/uploads/{upload_id}", UploadStatusHandler).Methods("HEAD")
+	r.HandleFunc("/uploads/{upload_id}", UploadChunkHandler).Methods("PATCH")
 
 	log.Printf("Staring main user server on port %d", *port)
 
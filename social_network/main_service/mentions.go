@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+// Mentions are at most this many per post, the rest of them are plain text
+const MaxMentions = 50
+
+// `@login` not preceded by a word character, so that emails are not mentions
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_])@([\p{L}\p{N}_]{1,64})`)
+
+// Returns mentioned logins in the order of their first appearance.
+func ExtractMentions(content string) []string {
+	var mentions []string
+	for _, match := range mentionRegexp.FindAllStringSubmatch(content, -1) {
+		if !slices.Contains(mentions, match[1]) {
+			mentions = append(mentions, match[1])
+		}
+	}
+	return mentions
+}
+
+// Keeps mentions of registered users only, users are stored in redis by login.
+func resolveMentions(ctx context.Context, content string) ([]string, error) {
+	var mentions []string
+	for _, login := range ExtractMentions(content) {
+		if len(mentions) == MaxMentions {
+			break
+		}
+		exists, err := redisClient.Exists(ctx, login).Result()
+		if err != nil {
+			return nil, err
+		}
+		if exists != 0 {
+			mentions = append(mentions, login)
+		}
+	}
+	return mentions, nil
+}
+
+// Lists published posts mentioning the user that the user can see, the newest go first.
+func ListMentionsHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	pbReq := &pb.TListMentionsRequest{Login: login}
+	if page := r.URL.Query().Get("page"); page != "" {
+		pbReq.PageId, err = strconv.ParseUint(page, 10, 64)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid page value %v", page) {
+			return
+		}
+	}
+
+	pbRes, err := postServiceClient.ListMentions(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to list mentions") {
+		return
+	}
+	err = markLikedByViewer(r.Context(), login, pbRes.Posts)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to get likes") {
+		return
+	}
+	respondProto(w, pbRes)
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+// Fetches the poll of a post the user can see.
+func getPoll(ctx context.Context, postId uint64, login string) (*pb.TPoll, error) {
+	pbRes, err := postServiceClient.GetPostById(ctx, &pb.TGetPostByIdRequest{PostId: postId, ViewerLogin: login})
+	if err != nil {
+		return nil, err
+	}
+	if pbRes.Post.Poll == nil {
+		return nil, status.Errorf(codes.NotFound, "post has no poll")
+	}
+	return pbRes.Post.Poll, nil
+}
+
+func pollClosed(poll *pb.TPoll) bool {
+	return poll.ClosesAt != nil && !time.Now().Before(poll.ClosesAt.AsTime())
+}
+
+type TPollVoteRequest struct {
+	OptionId uint32 `json:"OptionId"`
+}
+
+// Votes are counted by stats_service from PollVotesTopic. The voter sees the vote in the results right away, as
+// it is remembered until stats_service counts it. Voting again replaces the previous vote until the poll closes.
+func VotePollHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	postId, err := parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+	var req TPollVoteRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "bad request") {
+		return
+	}
+
+	poll, err := getPoll(r.Context(), postId, login)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to vote") {
+		return
+	}
+	if better_errors.CheckCustomHttp(pollClosed(poll), w, http.StatusConflict, "poll is closed") {
+		return
+	}
+	if better_errors.CheckCustomHttp(req.OptionId == 0 || int(req.OptionId) > len(poll.Options), w, http.StatusBadRequest, "unknown option %d", req.OptionId) {
+		return
+	}
+
+	vote := &pb.TPollVote{PostId: postId, Login: login, OptionId: req.OptionId, VotedAt: timestamppb.Now()}
+	serializedVote, err := proto.Marshal(vote)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to serialize poll vote message") {
+		return
+	}
+
+	// votes are keyed by post id like stats messages, so that the votes of a user are applied in order
+	message := &sarama.ProducerMessage{
+		Topic: "PollVotesTopic",
+		Key:   sarama.StringEncoder(strconv.FormatUint(postId, 10)),
+		Value: sarama.ByteEncoder(serializedVote),
+	}
+
+	select {
+	case kafkaProducer.Input() <- message:
+		err = redisClient.Set(r.Context(), pendingVoteKey(postId, login), req.OptionId, pendingVoteTTL).Err()
+		better_errors.CheckError(err, "failed to remember vote of %v in poll %v", login, postId)
+		w.WriteHeader(http.StatusAccepted)
+	case err := <-kafkaProducer.Errors():
+		better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to produce poll vote message")
+	}
+}
+
+// Votes wait in PollVotesTopic for a few seconds at most, a pending vote is remembered for much longer
+const pendingVoteTTL = 10 * time.Minute
+
+func pendingVoteKey(postId uint64, login string) string {
+	return fmt.Sprintf("poll_vote:%d:%s", postId, login)
+}
+
+// Votes are only shown to users who voted already, or to everyone once the poll closes.
+func PollResultsHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	postId, err := parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+
+	poll, err := getPoll(r.Context(), postId, login)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to get poll") {
+		return
+	}
+	pbRes, err := pollResults(r.Context(), postId, login, poll)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to get poll results") {
+		return
+	}
+	respondProto(w, pbRes)
+}
+
+// Combines the options of the poll with the votes counted by stats_service. A vote of the viewer that is not
+// counted yet replaces the counted one, so that voters read their own votes.
+func pollResults(ctx context.Context, postId uint64, login string, poll *pb.TPoll) (*pb.TGetPollResultsResponse, error) {
+	results, err := statsServiceClient.GetPollResults(ctx, &pb.TGetPollResultsRequest{PostId: postId, ViewerLogin: login})
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make(map[uint32]uint64, len(results.Options))
+	for _, option := range results.Options {
+		votes[option.OptionId] = option.Votes
+	}
+	pending, err := redisClient.Get(ctx, pendingVoteKey(postId, login)).Uint64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		better_errors.CheckError(err, "failed to get pending vote of %v in poll %v", login, postId)
+	}
+	if pending != 0 && uint32(pending) != results.ViewerOptionId {
+		if results.ViewerOptionId != 0 && votes[results.ViewerOptionId] > 0 {
+			votes[results.ViewerOptionId]--
+		}
+		votes[uint32(pending)]++
+		results.ViewerOptionId = uint32(pending)
+	}
+	pbRes := &pb.TGetPollResultsResponse{
+		ViewerOptionId: results.ViewerOptionId,
+		Closed:         pollClosed(poll),
+	}
+	pbRes.ResultsVisible = pbRes.Closed || pbRes.ViewerOptionId != 0
+	for _, option := range poll.Options {
+		resultOption := &pb.TGetPollResultsResponse_TOption{OptionId: option.OptionId, Text: option.Text}
+		if pbRes.ResultsVisible {
+			resultOption.Votes = votes[option.OptionId]
+			pbRes.TotalVotes += resultOption.Votes
+		}
+		pbRes.Options = append(pbRes.Options, resultOption)
+	}
+	return pbRes, nil
+}
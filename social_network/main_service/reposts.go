@@ -0,0 +1,50 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+// Shortcut for creating a REPOST of the post, quotes are created with `/posts/create`.
+func RepostHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	pbReq := &pb.TCreatePostRequest{AuthorLogin: login, Kind: pb.TPost_REPOST}
+	pbReq.RefPostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+
+	pbRes, err := postServiceClient.CreatePost(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to repost") {
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	respondProto(w, pbRes)
+}
+
+func ListNotificationsHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	pbReq := &pb.TListNotificationsRequest{Login: login}
+	if page := r.URL.Query().Get("page"); page != "" {
+		pbReq.PageId, err = strconv.ParseUint(page, 10, 64)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid page value %v", page) {
+			return
+		}
+	}
+
+	pbRes, err := postServiceClient.ListNotifications(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to list notifications") {
+		return
+	}
+	respondProto(w, pbRes)
+}
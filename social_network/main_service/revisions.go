@@ -0,0 +1,100 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+func ListPostRevisionsHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	pbReq := &pb.TListPostRevisionsRequest{ViewerLogin: login}
+	pbReq.PostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+
+	pbRes, err := postServiceClient.ListPostRevisions(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to list revisions") {
+		return
+	}
+	respondProto(w, pbRes)
+}
+
+func GetPostRevisionHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	pbReq := &pb.TGetPostRevisionRequest{ViewerLogin: login}
+	pbReq.PostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+	pbReq.RevisionId, err = parseUintVar(r, "revision_id")
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid revision id") {
+		return
+	}
+
+	pbRes, err := postServiceClient.GetPostRevision(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to get revision") {
+		return
+	}
+	respondProto(w, pbRes)
+}
+
+func DiffPostRevisionsHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	pbReq := &pb.TDiffPostRevisionsRequest{ViewerLogin: login}
+	pbReq.PostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+	query := r.URL.Query()
+	pbReq.FromRevisionId, err = strconv.ParseUint(query.Get("from"), 10, 64)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid `from` revision id") {
+		return
+	}
+	pbReq.ToRevisionId, err = strconv.ParseUint(query.Get("to"), 10, 64)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid `to` revision id") {
+		return
+	}
+
+	pbRes, err := postServiceClient.DiffPostRevisions(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to diff revisions") {
+		return
+	}
+	respondProto(w, pbRes)
+}
+
+func RestorePostRevisionHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	pbReq := &pb.TRestorePostRevisionRequest{}
+	pbReq.AuthorLogin = login // set login from token
+	pbReq.PostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+	pbReq.RevisionId, err = parseUintVar(r, "revision_id")
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid revision id") {
+		return
+	}
+
+	pbRes, err := postServiceClient.RestorePostRevision(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusBadRequest), "failed to restore revision") {
+		return
+	}
+	respondProto(w, pbRes)
+}
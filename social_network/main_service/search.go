@@ -0,0 +1,64 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+func SearchPostsHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	query := r.URL.Query()
+	pbReq := &pb.TSearchPostsRequest{
+		Query:       query.Get("q"),
+		AuthorLogin: query.Get("author"),
+		ViewerLogin: login,
+	}
+	if better_errors.CheckCustomHttp(pbReq.Query == "", w, http.StatusBadRequest, "query `q` is required") {
+		return
+	}
+	if from := query.Get("from"); from != "" {
+		fromTime, err := time.Parse(time.RFC3339, from)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid `from` time %v, RFC 3339 expected", from) {
+			return
+		}
+		pbReq.CreatedFrom = timestamppb.New(fromTime)
+	}
+	if to := query.Get("to"); to != "" {
+		toTime, err := time.Parse(time.RFC3339, to)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid `to` time %v, RFC 3339 expected", to) {
+			return
+		}
+		pbReq.CreatedTo = timestamppb.New(toTime)
+	}
+	if page := query.Get("page"); page != "" {
+		pbReq.PageId, err = strconv.ParseUint(page, 10, 64)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid page value %v", page) {
+			return
+		}
+	}
+
+	pbRes, err := postServiceClient.SearchPosts(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to search posts") {
+		return
+	}
+	posts := make([]*pb.TPost, 0, len(pbRes.Hits))
+	for _, hit := range pbRes.Hits {
+		posts = append(posts, hit.Post)
+	}
+	err = markLikedByViewer(r.Context(), login, posts)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to get likes") {
+		return
+	}
+	respondProto(w, pbRes)
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+func GetPostsByTagHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbReq := &pb.TGetPostsByTagRequest{Tag: mux.Vars(r)["tag"], ViewerLogin: login}
+	if page := r.URL.Query().Get("page"); page != "" {
+		pbReq.PageId, err = strconv.ParseUint(page, 10, 64)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid page value %v", page) {
+			return
+		}
+	}
+
+	pbRes, err := postServiceClient.GetPostsByTag(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to process request") {
+		return
+	}
+	err = markLikedByViewer(r.Context(), login, pbRes.Posts)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to get likes") {
+		return
+	}
+	respondProto(w, pbRes)
+}
+
+func TrendingTagsHandler(w http.ResponseWriter, r *http.Request) {
+	_, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbReq := &pb.TGetTrendingTagsRequest{}
+	query := r.URL.Query()
+	if window := query.Get("window"); window != "" {
+		duration, err := time.ParseDuration(window)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid window %v", window) {
+			return
+		}
+		if better_errors.CheckCustomHttp(duration < time.Second, w, http.StatusBadRequest, "window should be at least 1s") {
+			return
+		}
+		pbReq.WindowSeconds = uint64(duration.Seconds())
+	}
+	if limit := query.Get("limit"); limit != "" {
+		parsedLimit, err := strconv.ParseUint(limit, 10, 32)
+		if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid limit %v", limit) {
+			return
+		}
+		pbReq.Limit = uint32(parsedLimit)
+	}
+
+	pbRes, err := statsServiceClient.GetTrendingTags(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to process request") {
+		return
+	}
+	respondProto(w, pbRes)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+// Serves the views and likes of a post by `bucket` (minute, hour or day) within `from` and `to` in RFC 3339.
+func PostTimeSeriesHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	pbReq := &pb.TGetPostTimeSeriesRequest{}
+	pbReq.PostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+	err = checkPostVisible(r.Context(), pbReq.PostId, login)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to get post") {
+		return
+	}
+	query := r.URL.Query()
+	if bucket := query.Get("bucket"); bucket != "" {
+		value, ok := pb.TGetPostTimeSeriesRequest_EBucket_value[strings.ToUpper(bucket)]
+		if better_errors.CheckCustomHttp(!ok, w, http.StatusBadRequest, "bucket should be `minute`, `hour` or `day`") {
+			return
+		}
+		pbReq.Bucket = pb.TGetPostTimeSeriesRequest_EBucket(value)
+	}
+	pbReq.From, err = parseTimeParam(query, "from")
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "%v", err) {
+		return
+	}
+	pbReq.To, err = parseTimeParam(query, "to")
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "%v", err) {
+		return
+	}
+
+	pbRes, err := statsServiceClient.GetPostTimeSeries(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to process request") {
+		return
+	}
+	respondProto(w, pbRes)
+}
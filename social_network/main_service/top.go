@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+var topWindowPresets = map[string]pb.TTimeWindow_EPreset{
+	"all":  pb.TTimeWindow_ALL_TIME,
+	"hour": pb.TTimeWindow_LAST_HOUR,
+	"day":  pb.TTimeWindow_LAST_DAY,
+	"week": pb.TTimeWindow_LAST_WEEK,
+}
+
+type TTopQuery struct {
+	OrderBy pb.TGetTopPostsRequest_EOrderBy
+	Limit   uint32
+	Window  *pb.TTimeWindow
+	PageId  uint64
+}
+
+// Parses the metric name, e.g. `unique_viewers` for UNIQUE_VIEWERS, and the query parameters of the rankings:
+// `limit`, `page`, `window` (hour, day, week or all) and an explicit range `from`/`to` in RFC 3339.
+// Failures are reported as bad requests.
+func parseTopQuery(metric string, query url.Values) (TTopQuery, error) {
+	var top TTopQuery
+	orderBy, ok := pb.TGetTopPostsRequest_EOrderBy_value[strings.ToUpper(metric)]
+	if !ok {
+		return top, fmt.Errorf("unknown metric %q, should be `likes`, `views`, `unique_viewers` or `engagement_rate`", metric)
+	}
+	top.OrderBy = pb.TGetTopPostsRequest_EOrderBy(orderBy)
+
+	if limit := query.Get("limit"); limit != "" {
+		parsed, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			return top, fmt.Errorf("invalid limit %v", limit)
+		}
+		top.Limit = uint32(parsed)
+	}
+	if page := query.Get("page"); page != "" {
+		parsed, err := strconv.ParseUint(page, 10, 64)
+		if err != nil {
+			return top, fmt.Errorf("invalid page value %v", page)
+		}
+		top.PageId = parsed
+	}
+
+	top.Window = &pb.TTimeWindow{}
+	if window := query.Get("window"); window != "" {
+		preset, ok := topWindowPresets[window]
+		if !ok {
+			return top, fmt.Errorf("unknown window %q, should be `hour`, `day`, `week` or `all`", window)
+		}
+		top.Window.Preset = preset
+	}
+	var err error
+	if top.Window.From, err = parseTimeParam(query, "from"); err != nil {
+		return top, err
+	}
+	if top.Window.To, err = parseTimeParam(query, "to"); err != nil {
+		return top, err
+	}
+	return top, nil
+}
+
+// Returns nil if the parameter is not set.
+func parseTimeParam(query url.Values, name string) (*timestamppb.Timestamp, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v %v, should be RFC 3339", name, value)
+	}
+	return timestamppb.New(parsed), nil
+}
+
+func TopPostsHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	top, err := parseTopQuery(mux.Vars(r)["type"], r.URL.Query())
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid ranking parameters: %v", err) {
+		return
+	}
+
+	// stats_service knows nothing about follows, so followers-only posts are matched against this list
+	following, err := postServiceClient.ListFollowing(r.Context(), &pb.TListFollowingRequest{Login: login})
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to process request") {
+		return
+	}
+	pbRes, err := statsServiceClient.GetTopPosts(r.Context(), &pb.TGetTopPostsRequest{
+		OrderBy:     top.OrderBy,
+		ViewerLogin: login,
+		Followees:   following.Logins,
+		Limit:       top.Limit,
+		Window:      top.Window,
+		PageId:      top.PageId,
+	})
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to process request") {
+		return
+	}
+	respondProto(w, pbRes)
+}
+
+// Authors are ranked by likes unless `metric` says otherwise.
+func TopAuthorsHandler(w http.ResponseWriter, r *http.Request) {
+	_, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	metric := r.URL.Query().Get("metric")
+	if metric == "" {
+		metric = "likes"
+	}
+	top, err := parseTopQuery(metric, r.URL.Query())
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid ranking parameters: %v", err) {
+		return
+	}
+
+	pbRes, err := statsServiceClient.GetTopAuthors(r.Context(), &pb.TGetTopAuthorsRequest{
+		OrderBy: top.OrderBy,
+		Limit:   top.Limit,
+		Window:  top.Window,
+		PageId:  top.PageId,
+	})
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to process request") {
+		return
+	}
+	respondProto(w, pbRes)
+}
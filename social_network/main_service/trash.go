@@ -0,0 +1,41 @@
+package main
+
+import (
+	"net/http"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+func ListTrashHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	pbRes, err := postServiceClient.ListTrash(r.Context(), &pb.TListTrashRequest{AuthorLogin: login})
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to list trash") {
+		return
+	}
+	respondProto(w, pbRes)
+}
+
+func RestorePostHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	pbReq := &pb.TRestorePostRequest{}
+	pbReq.AuthorLogin = login // set login from token
+	pbReq.PostId, err = parsePostId(r)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid post id") {
+		return
+	}
+
+	_, err = postServiceClient.RestorePost(r.Context(), pbReq)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusBadRequest), "failed to restore post") {
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
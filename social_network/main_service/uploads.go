@@ -0,0 +1,280 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/gorilla/mux"
+
+	"auth"
+	"better_errors"
+	pb "proto"
+)
+
+// Unfinished uploads are forgotten after that, their chunks are left for the storage lifecycle rules
+const uploadSessionTTL = 24 * time.Hour
+
+// State of a resumable upload, chunks are stored as separate blobs until the last one arrives.
+type TUploadSession struct {
+	Login       string  `json:"login"`
+	PostId      uint64  `json:"postId"`
+	FileName    string  `json:"fileName"`
+	Size        int64   `json:"size"`
+	ContentType string  `json:"contentType"`
+	Offset      int64   `json:"offset"`
+	Chunks      []int64 `json:"chunks"`
+}
+
+func uploadSessionKey(uploadId string) string {
+	return "upload:" + uploadId
+}
+
+func uploadChunkKey(uploadId string, offset int64) string {
+	return fmt.Sprintf("uploads/%s/%020d", uploadId, offset)
+}
+
+func loadUploadSession(ctx context.Context, uploadId string, login string) (*TUploadSession, error) {
+	data, err := redisClient.Get(ctx, uploadSessionKey(uploadId)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	var session TUploadSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, err
+	}
+	if session.Login != login {
+		// do not reveal uploads of other users
+		return nil, redis.Nil
+	}
+	return &session, nil
+}
+
+func saveUploadSession(ctx context.Context, uploadId string, session *TUploadSession) error {
+	data, _ := json.Marshal(session)
+	return redisClient.Set(ctx, uploadSessionKey(uploadId), data, uploadSessionTTL).Err()
+}
+
+func deleteUploadChunks(ctx context.Context, uploadId string, session *TUploadSession) {
+	for _, offset := range session.Chunks {
+		key := uploadChunkKey(uploadId, offset)
+		better_errors.CheckError(blobStore.Delete(ctx, key), "failed to delete blob %v", key)
+	}
+}
+
+func setUploadHeaders(w http.ResponseWriter, session *TUploadSession) {
+	w.Header().Set("Upload-Offset", strconv.FormatInt(session.Offset, 10))
+	w.Header().Set("Upload-Length", strconv.FormatInt(session.Size, 10))
+	w.Header().Set("Cache-Control", "no-store")
+}
+
+type TCreateUploadRequest struct {
+	PostId   uint64 `json:"postId"`
+	FileName string `json:"fileName"`
+	Size     int64  `json:"size"`
+}
+
+func CreateUploadHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	var req TCreateUploadRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "bad request") {
+		return
+	}
+	if better_errors.CheckCustomHttp(req.Size <= 0, w, http.StatusBadRequest, "size should be positive") {
+		return
+	}
+	if better_errors.CheckCustomHttp(req.Size > maxAttachmentSize, w, http.StatusRequestEntityTooLarge, "attachments can not be larger than %d bytes", maxAttachmentSize) {
+		return
+	}
+	err = checkPostAuthor(r.Context(), req.PostId, login)
+	if better_errors.CheckHttpError(err, w, httpCodeFromGrpc(err, http.StatusInternalServerError), "failed to create upload") {
+		return
+	}
+
+	uploadId := randomId()
+	session := &TUploadSession{Login: login, PostId: req.PostId, FileName: req.FileName, Size: req.Size}
+	err = saveUploadSession(r.Context(), uploadId, session)
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to create upload") {
+		return
+	}
+
+	setUploadHeaders(w, session)
+	w.Header().Set("Location", "/uploads/"+uploadId)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]string{"uploadId": uploadId})
+}
+
+// Lets the client find out where to resume from.
+func UploadStatusHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+
+	session, err := loadUploadSession(r.Context(), mux.Vars(r)["upload_id"], login)
+	if better_errors.CheckCustomHttp(errors.Is(err, redis.Nil), w, http.StatusNotFound, "upload not found") {
+		return
+	}
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to get upload") {
+		return
+	}
+	setUploadHeaders(w, session)
+	w.WriteHeader(http.StatusOK)
+}
+
+// Appends the request body at the `Upload-Offset` of the upload, the last chunk turns the upload into an attachment.
+func UploadChunkHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := auth.VerifyToken(r, authHandler)
+	if better_errors.CheckHttpError(err, w, http.StatusUnauthorized, "invalid token") {
+		return
+	}
+	uploadId := mux.Vars(r)["upload_id"]
+	offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if better_errors.CheckHttpError(err, w, http.StatusBadRequest, "invalid Upload-Offset header") {
+		return
+	}
+
+	// chunks of the same upload are written one at a time
+	lockKey := uploadSessionKey(uploadId) + ":lock"
+	locked, err := redisClient.SetNX(r.Context(), lockKey, login, time.Minute).Result()
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to lock upload") {
+		return
+	}
+	if better_errors.CheckCustomHttp(!locked, w, http.StatusConflict, "another chunk of this upload is being written") {
+		return
+	}
+	defer redisClient.Del(context.Background(), lockKey)
+
+	session, err := loadUploadSession(r.Context(), uploadId, login)
+	if better_errors.CheckCustomHttp(errors.Is(err, redis.Nil), w, http.StatusNotFound, "upload not found") {
+		return
+	}
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to get upload") {
+		return
+	}
+	if offset != session.Offset {
+		setUploadHeaders(w, session)
+		better_errors.CheckCustomHttp(true, w, http.StatusConflict, "upload offset is %d, but %d given", session.Offset, offset)
+		return
+	}
+
+	var maxBytesErr *http.MaxBytesError
+	body := http.MaxBytesReader(w, r.Body, session.Size-session.Offset)
+	var reader io.Reader = body
+	if session.Offset == 0 {
+		head := make([]byte, sniffLength)
+		n, err := io.ReadFull(body, head)
+		if better_errors.CheckCustomHttp(errors.As(err, &maxBytesErr), w, http.StatusRequestEntityTooLarge, "chunk exceeds the upload size") {
+			return
+		}
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			better_errors.CheckHttpError(err, w, http.StatusBadRequest, "failed to read chunk")
+			return
+		}
+		session.ContentType, err = detectContentType(head[:n])
+		if better_errors.CheckHttpError(err, w, http.StatusUnsupportedMediaType, "failed to upload chunk") {
+			return
+		}
+		reader = io.MultiReader(bytes.NewReader(head[:n]), body)
+	}
+	chunk := &countingReader{r: reader}
+
+	chunkKey := uploadChunkKey(uploadId, session.Offset)
+	err = blobStore.Put(r.Context(), chunkKey, chunk, r.ContentLength, "application/octet-stream")
+	if better_errors.CheckCustomHttp(errors.As(err, &maxBytesErr), w, http.StatusRequestEntityTooLarge, "chunk exceeds the upload size") {
+		return
+	}
+	if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to store chunk") {
+		return
+	}
+	if chunk.n > 0 {
+		session.Chunks = append(session.Chunks, session.Offset)
+		session.Offset += chunk.n
+	}
+
+	if session.Offset < session.Size {
+		err = saveUploadSession(r.Context(), uploadId, session)
+		if better_errors.CheckHttpError(err, w, http.StatusInternalServerError, "failed to save upload") {
+			return
+		}
+		setUploadHeaders(w, session)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	pbRes, err := completeUpload(r.Context(), uploadId, session)
+	if better_errors.CheckHttpError(err, w, httpCodeFromUpload(err), "failed to complete upload") {
+		return
+	}
+	setUploadHeaders(w, session)
+	w.WriteHeader(http.StatusCreated)
+	respondProto(w, pbRes)
+}
+
+// Concatenates the chunks into the attachment blob and drops the session.
+func completeUpload(ctx context.Context, uploadId string, session *TUploadSession) (*pb.TAttachmentResponse, error) {
+	pr, pw := io.Pipe()
+	go func() {
+		for _, offset := range session.Chunks {
+			chunk, err := blobStore.Get(ctx, uploadChunkKey(uploadId, offset))
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			_, err = io.Copy(pw, chunk)
+			chunk.Close()
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		pw.Close()
+	}()
+
+	key := newAttachmentKey(session.PostId)
+	err := blobStore.Put(ctx, key, pr, session.Size, session.ContentType)
+	pr.Close()
+	if err != nil {
+		return nil, err
+	}
+	pbRes, err := addAttachment(ctx, &pb.TAddAttachmentRequest{
+		PostId:      session.PostId,
+		AuthorLogin: session.Login,
+		FileName:    session.FileName,
+		ContentType: session.ContentType,
+		Size:        uint64(session.Size),
+		BlobKey:     key,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deleteUploadChunks(ctx, uploadId, session)
+	better_errors.CheckError(redisClient.Del(ctx, uploadSessionKey(uploadId)).Err(), "failed to delete upload %v", uploadId)
+	return pbRes, nil
+}
+
+// Remembers how many bytes of the chunk were actually stored.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"blobstore"
+	pb "proto"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Attachment files themselves are uploaded by main_service, post_service only removes them together with metadata
+var blobStore blobstore.BlobStore
+
+const attachmentColumns = "AttachmentId, PostId, FileName, ContentType, Size, BlobKey, CreatedAt, Width, Height, BlurHash"
+
+// Scans attachmentColumns followed by the extra destinations.
+func scanAttachment(row rowScanner, extra ...any) (*pb.TAttachment, error) {
+	var attachment pb.TAttachment
+	var createdAt time.Time
+	dest := append([]any{
+		&attachment.AttachmentId,
+		&attachment.PostId,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.BlobKey,
+		&createdAt,
+		&attachment.Width,
+		&attachment.Height,
+		&attachment.BlurHash,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	attachment.CreatedAt = timestamppb.New(createdAt)
+	return &attachment, nil
+}
+
+func loadAttachments(ctx context.Context, posts []*pb.TPost) error {
+	if len(posts) == 0 {
+		return nil
+	}
+	byId := make(map[uint64]*pb.TPost, len(posts))
+	ids := make([]int64, 0, len(posts))
+	for _, post := range posts {
+		byId[post.PostId] = post
+		ids = append(ids, int64(post.PostId))
+	}
+
+	rows, err := db.QueryContext(
+		ctx,
+		"SELECT "+attachmentColumns+" FROM ATTACHMENTS WHERE PostId = ANY($1) ORDER BY AttachmentId",
+		pq.Array(ids),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var attachments []*pb.TAttachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return err
+		}
+		if post, ok := byId[attachment.PostId]; ok {
+			post.Attachments = append(post.Attachments, attachment)
+			attachments = append(attachments, attachment)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return loadImageVariants(ctx, attachments)
+}
+
+func (s *server) AddAttachment(ctx context.Context, req *pb.TAddAttachmentRequest) (*pb.TAttachmentResponse, error) {
+	if req.BlobKey == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "blob key must not be empty")
+	}
+
+	var authorLogin string
+	err := db.QueryRowContext(ctx, "SELECT AuthorLogin FROM POSTS WHERE PostId = $1 AND DeletedAt IS NULL", req.PostId).Scan(&authorLogin)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add attachment: %v", err)
+	}
+	if authorLogin != req.AuthorLogin {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not the author of this post")
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add attachment: %v", err)
+	}
+	defer tx.Rollback()
+
+	attachment, err := scanAttachment(tx.QueryRowContext(
+		ctx,
+		"INSERT INTO ATTACHMENTS (PostId, FileName, ContentType, Size, BlobKey) VALUES ($1, $2, $3, $4, $5) RETURNING "+attachmentColumns,
+		req.PostId,
+		req.FileName,
+		req.ContentType,
+		req.Size,
+		req.BlobKey,
+	))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add attachment: %v", err)
+	}
+	if isProcessableImage(attachment.ContentType) {
+		if attachment.Variants, err = insertPendingVariants(ctx, tx, attachment.AttachmentId); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to add attachment: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add attachment: %v", err)
+	}
+	if len(attachment.Variants) > 0 {
+		enqueueImageJob(ctx, attachment)
+	}
+	return &pb.TAttachmentResponse{Attachment: attachment}, nil
+}
+
+func (s *server) GetAttachment(ctx context.Context, req *pb.TGetAttachmentRequest) (*pb.TAttachmentResponse, error) {
+	attachment, err := scanAttachment(db.QueryRowContext(
+		ctx,
+		`SELECT `+attachmentColumns+` FROM ATTACHMENTS
+		WHERE AttachmentId = $1 AND PostId IN (SELECT p.PostId FROM POSTS AS p WHERE `+visibleToCondition("$2")+`)`,
+		req.AttachmentId,
+		req.ViewerLogin,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "attachment not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch attachment: %v", err)
+	}
+	if err := loadImageVariants(ctx, []*pb.TAttachment{attachment}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch image variants: %v", err)
+	}
+	return &pb.TAttachmentResponse{Attachment: attachment}, nil
+}
+
+func (s *server) DeleteAttachment(ctx context.Context, req *pb.TDeleteAttachmentRequest) (*pb.TAttachmentResponse, error) {
+	// variants are removed by cascade at the end of the statement, so the outer select still sees them
+	var variantKeys []string
+	attachment, err := scanAttachment(db.QueryRowContext(
+		ctx,
+		`WITH deleted AS (
+			DELETE FROM ATTACHMENTS
+			WHERE AttachmentId = $1 AND PostId IN (SELECT PostId FROM POSTS WHERE AuthorLogin = $2 AND DeletedAt IS NULL)
+			RETURNING `+attachmentColumns+`
+		)
+		SELECT `+attachmentColumns+`, ARRAY(SELECT BlobKey FROM ATTACHMENT_VARIANTS AS v WHERE v.AttachmentId = deleted.AttachmentId AND v.BlobKey <> '')
+		FROM deleted`,
+		req.AttachmentId,
+		req.AuthorLogin,
+	), pq.Array(&variantKeys))
+	if errors.Is(err, sql.ErrNoRows) {
+		var authorLogin string
+		err := db.QueryRowContext(
+			ctx,
+			"SELECT p.AuthorLogin FROM ATTACHMENTS AS a JOIN POSTS AS p ON p.PostId = a.PostId WHERE a.AttachmentId = $1 AND p.DeletedAt IS NULL",
+			req.AttachmentId,
+		).Scan(&authorLogin)
+		if err == nil && authorLogin != req.AuthorLogin {
+			return nil, status.Errorf(codes.PermissionDenied, "you are not the author of this post")
+		}
+		return nil, status.Errorf(codes.NotFound, "attachment not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete attachment: %v", err)
+	}
+	deleteBlobs(ctx, append(variantKeys, attachment.BlobKey))
+	return &pb.TAttachmentResponse{Attachment: attachment}, nil
+}
+
+// Metadata is already gone when blobs are deleted, so failures only leave orphaned files behind and are logged.
+func deleteBlobs(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := blobStore.Delete(ctx, key); err != nil {
+			log.Printf("failed to delete blob %s: %v", key, err)
+		}
+	}
+}
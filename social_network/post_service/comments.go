@@ -0,0 +1,328 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Replies deeper than this are rejected, depth of top-level comments is 0
+	MaxCommentDepth      = 5
+	DefaultCommentsLimit = 20
+	MaxCommentsLimit     = 100
+)
+
+const commentColumns = "CommentId, PostId, ParentId, Depth, AuthorLogin, Content, DeletedAt IS NOT NULL, Likes, RepliesCount, CreatedAt, UpdatedAt"
+
+func scanComment(row rowScanner) (*pb.TComment, error) {
+	var comment pb.TComment
+	var createdAt, updatedAt time.Time
+	err := row.Scan(
+		&comment.CommentId,
+		&comment.PostId,
+		&comment.ParentId,
+		&comment.Depth,
+		&comment.AuthorLogin,
+		&comment.Content,
+		&comment.Deleted,
+		&comment.Likes,
+		&comment.RepliesCount,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	comment.CreatedAt = timestamppb.New(createdAt)
+	comment.UpdatedAt = timestamppb.New(updatedAt)
+	return &comment, nil
+}
+
+func (s *server) CreateComment(ctx context.Context, req *pb.TCreateCommentRequest) (*pb.TCommentResponse, error) {
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "comment must not be empty")
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT EXISTS (SELECT 1 FROM POSTS AS p WHERE p.PostId = $1 AND p.Status = 'PUBLISHED' AND "+visibleToCondition("$2")+")",
+		req.PostId,
+		req.AuthorLogin,
+	).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+
+	depth := 0
+	if req.ParentId != 0 {
+		var parentDepth int
+		err := tx.QueryRowContext(
+			ctx,
+			"UPDATE COMMENTS SET RepliesCount = RepliesCount + 1 WHERE CommentId = $1 AND PostId = $2 RETURNING Depth",
+			req.ParentId,
+			req.PostId,
+		).Scan(&parentDepth)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "parent comment not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
+		}
+		depth = parentDepth + 1
+		if depth > MaxCommentDepth {
+			return nil, status.Errorf(codes.InvalidArgument, "replies can not be nested deeper than %d levels", MaxCommentDepth)
+		}
+	}
+
+	comment, err := scanComment(tx.QueryRowContext(
+		ctx,
+		"INSERT INTO COMMENTS (PostId, ParentId, Depth, AuthorLogin, Content) VALUES ($1, $2, $3, $4, $5) RETURNING "+commentColumns,
+		req.PostId,
+		req.ParentId,
+		depth,
+		req.AuthorLogin,
+		req.Content,
+	))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
+	}
+	return &pb.TCommentResponse{Comment: comment}, nil
+}
+
+// Matches comments of posts the viewer may see, like the read path does. COMMENTS must not be aliased.
+func commentVisibleToCondition(viewer string) string {
+	return "EXISTS (SELECT 1 FROM POSTS AS p WHERE p.PostId = COMMENTS.PostId AND " + visibleToCondition(viewer) + ")"
+}
+
+// Tells apart missing comments from someone else's ones when a guarded statement matched no rows. Comments of
+// posts the user can not see are missing as well.
+func commentAccessError(ctx context.Context, commentId uint64, authorLogin string) error {
+	var actualAuthor string
+	err := db.QueryRowContext(
+		ctx,
+		"SELECT AuthorLogin FROM COMMENTS WHERE CommentId = $1 AND DeletedAt IS NULL AND "+commentVisibleToCondition("$2"),
+		commentId,
+		authorLogin,
+	).Scan(&actualAuthor)
+	if err == nil && actualAuthor != authorLogin {
+		return status.Errorf(codes.PermissionDenied, "you are not the author of this comment")
+	}
+	return status.Errorf(codes.NotFound, "comment not found")
+}
+
+func (s *server) UpdateComment(ctx context.Context, req *pb.TUpdateCommentRequest) (*pb.TCommentResponse, error) {
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "comment must not be empty")
+	}
+
+	comment, err := scanComment(db.QueryRowContext(
+		ctx,
+		"UPDATE COMMENTS SET Content = $1, UpdatedAt = now() WHERE CommentId = $2 AND AuthorLogin = $3 AND DeletedAt IS NULL AND "+
+			commentVisibleToCondition("$3")+" RETURNING "+commentColumns,
+		req.Content,
+		req.CommentId,
+		req.AuthorLogin,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, commentAccessError(ctx, req.CommentId, req.AuthorLogin)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update comment: %v", err)
+	}
+	return &pb.TCommentResponse{Comment: comment}, nil
+}
+
+// Deleted comments stay as tombstones so that their replies are still reachable.
+func (s *server) DeleteComment(ctx context.Context, req *pb.TDeleteCommentRequest) (*emptypb.Empty, error) {
+	result, err := db.ExecContext(
+		ctx,
+		"UPDATE COMMENTS SET Content = '', DeletedAt = now() WHERE CommentId = $1 AND AuthorLogin = $2 AND DeletedAt IS NULL AND "+
+			commentVisibleToCondition("$2"),
+		req.CommentId,
+		req.AuthorLogin,
+	)
+	if err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to delete comment: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to delete comment: %v", err)
+	}
+	if rowsAffected == 0 {
+		return &emptypb.Empty{}, commentAccessError(ctx, req.CommentId, req.AuthorLogin)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Cursors point at the last comment of the previous page: `<likes>:<comment id>`.
+func encodeCommentsCursor(comment *pb.TComment) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", comment.Likes, comment.CommentId)))
+}
+
+func decodeCommentsCursor(cursor string) (likes uint64, commentId uint64, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, 0, err
+	}
+	_, err = fmt.Sscanf(string(raw), "%d:%d", &likes, &commentId)
+	return likes, commentId, err
+}
+
+func (s *server) ListComments(ctx context.Context, req *pb.TListCommentsRequest) (*pb.TListCommentsResponse, error) {
+	if err := checkPostVisible(ctx, req.PostId, req.ViewerLogin); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = DefaultCommentsLimit
+	}
+	limit = min(limit, MaxCommentsLimit)
+
+	var cursorLikes, cursorId uint64
+	if req.Cursor != "" {
+		var err error
+		cursorLikes, cursorId, err = decodeCommentsCursor(req.Cursor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	args := []any{req.PostId, req.ParentId, cursorId}
+	var condition, order string
+	switch req.Order {
+	case pb.TListCommentsRequest_NEWEST:
+		condition = "($3 = 0 OR CommentId < $3)"
+		order = "CommentId DESC"
+	case pb.TListCommentsRequest_OLDEST:
+		condition = "CommentId > $3"
+		order = "CommentId ASC"
+	case pb.TListCommentsRequest_TOP:
+		condition = "($3 = 0 OR (Likes, CommentId) < ($4, $3))"
+		order = "Likes DESC, CommentId DESC"
+		args = append(args, cursorLikes)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown order %v", req.Order)
+	}
+	// one extra row tells whether there is a next page
+	args = append(args, limit+1)
+
+	rows, err := db.QueryContext(
+		ctx,
+		fmt.Sprintf(
+			"SELECT %s FROM COMMENTS WHERE PostId = $1 AND ParentId = $2 AND %s ORDER BY %s LIMIT $%d",
+			commentColumns,
+			condition,
+			order,
+			len(args),
+		),
+		args...,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch comments: %v", err)
+	}
+	defer rows.Close()
+
+	response := &pb.TListCommentsResponse{}
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan comment: %v", err)
+		}
+		response.Comments = append(response.Comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating over rows: %v", err)
+	}
+
+	if len(response.Comments) > limit {
+		response.Comments = response.Comments[:limit]
+		response.NextCursor = encodeCommentsCursor(response.Comments[limit-1])
+	}
+	return response, nil
+}
+
+// Likes are unique per user, so repeated calls do not change the counter.
+func (s *server) LikeComment(ctx context.Context, req *pb.TLikeCommentRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, setCommentLike(ctx, req, true)
+}
+
+func (s *server) UnlikeComment(ctx context.Context, req *pb.TLikeCommentRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, setCommentLike(ctx, req, false)
+}
+
+func setCommentLike(ctx context.Context, req *pb.TLikeCommentRequest, liked bool) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to like comment: %v", err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT EXISTS (SELECT 1 FROM COMMENTS WHERE CommentId = $1 AND DeletedAt IS NULL AND "+commentVisibleToCondition("$2")+")",
+		req.CommentId,
+		req.Login,
+	).Scan(&exists)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to like comment: %v", err)
+	}
+	if !exists {
+		return status.Errorf(codes.NotFound, "comment not found")
+	}
+
+	var result sql.Result
+	var delta int
+	if liked {
+		result, err = tx.ExecContext(ctx, "INSERT INTO COMMENT_LIKES (CommentId, Login) VALUES ($1, $2) ON CONFLICT DO NOTHING", req.CommentId, req.Login)
+		delta = 1
+	} else {
+		result, err = tx.ExecContext(ctx, "DELETE FROM COMMENT_LIKES WHERE CommentId = $1 AND Login = $2", req.CommentId, req.Login)
+		delta = -1
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to like comment: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to like comment: %v", err)
+	}
+	if rowsAffected != 0 {
+		_, err = tx.ExecContext(ctx, "UPDATE COMMENTS SET Likes = Likes + $1 WHERE CommentId = $2", delta, req.CommentId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to like comment: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "failed to like comment: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"strings"
+
+	pb "proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Bounds the LCS table of a diff to MaxDiffLines² cells. Lines shared at the start and at the end of both texts are
+// not counted, so small edits of long posts can still be diffed.
+const MaxDiffLines = 2000
+
+// Line-based diff built on the longest common subsequence of both texts.
+func DiffLines(from string, to string) ([]*pb.TDiffLine, error) {
+	a := splitLines(from)
+	b := splitLines(to)
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	var diff []*pb.TDiffLine
+	for _, line := range a[:prefix] {
+		diff = append(diff, &pb.TDiffLine{Op: pb.TDiffLine_EQUAL, Text: line})
+	}
+	common := a[len(a)-suffix:]
+	a, b = a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if len(a) > MaxDiffLines || len(b) > MaxDiffLines {
+		return nil, status.Errorf(codes.InvalidArgument, "revisions differ in more than %d lines", MaxDiffLines)
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			diff = append(diff, &pb.TDiffLine{Op: pb.TDiffLine_EQUAL, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, &pb.TDiffLine{Op: pb.TDiffLine_DELETE, Text: a[i]})
+			i++
+		default:
+			diff = append(diff, &pb.TDiffLine{Op: pb.TDiffLine_INSERT, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		diff = append(diff, &pb.TDiffLine{Op: pb.TDiffLine_DELETE, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		diff = append(diff, &pb.TDiffLine{Op: pb.TDiffLine_INSERT, Text: b[j]})
+	}
+	for _, line := range common {
+		diff = append(diff, &pb.TDiffLine{Op: pb.TDiffLine_EQUAL, Text: line})
+	}
+	return diff, nil
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
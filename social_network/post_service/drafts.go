@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	pb "proto"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Posts published by one scheduler run at most, the rest wait for the next tick
+const publishBatchSize = 100
+
+// Tells stats_service that the post is visible from now on, once the transaction commits.
+func enqueuePostPublished(ctx context.Context, tx *sql.Tx, postId uint64, authorLogin string, visibility pb.TPost_EVisibility) error {
+	return enqueuePostEvent(ctx, tx, &pb.TPostEvent{
+		Type:        pb.TPostEvent_PUBLISHED,
+		PostId:      postId,
+		AuthorLogin: authorLogin,
+		Visibility:  visibility,
+	})
+}
+
+func (s *server) PublishPost(ctx context.Context, req *pb.TPublishPostRequest) (*pb.TGetPostByIdResponse, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish post: %v", err)
+	}
+	defer tx.Rollback()
+
+	var authorLogin, postStatus, visibility string
+	var mentions []string
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT AuthorLogin, Status, Visibility, Mentions FROM POSTS WHERE PostId = $1 AND DeletedAt IS NULL FOR UPDATE",
+		req.PostId,
+	).Scan(&authorLogin, &postStatus, &visibility, pq.Array(&mentions))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish post: %v", err)
+	}
+	if authorLogin != req.AuthorLogin {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not the author of this post")
+	}
+	if postStatus == pb.TPost_PUBLISHED.String() {
+		return nil, status.Errorf(codes.FailedPrecondition, "post is already published")
+	}
+
+	publishNow := req.PublishAt == nil || !req.PublishAt.AsTime().After(time.Now())
+	if !publishNow {
+		_, err = tx.ExecContext(ctx, "UPDATE POSTS SET Status = 'SCHEDULED', PublishAt = $1 WHERE PostId = $2", req.PublishAt.AsTime(), req.PostId)
+	} else {
+		_, err = tx.ExecContext(ctx, "UPDATE POSTS SET Status = 'PUBLISHED', PublishAt = now() WHERE PostId = $1", req.PostId)
+		if err == nil {
+			err = enqueuePostPublished(ctx, tx, req.PostId, authorLogin, pb.TPost_EVisibility(pb.TPost_EVisibility_value[visibility]))
+		}
+		if err == nil {
+			err = notifyMentions(ctx, tx, req.PostId, mentions)
+		}
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish post: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish post: %v", err)
+	}
+	if publishNow {
+		wakeRelay()
+	}
+
+	return s.GetPostById(ctx, &pb.TGetPostByIdRequest{PostId: req.PostId, ViewerLogin: req.AuthorLogin})
+}
+
+// Lists drafts and scheduled posts of the author, the ones to be published first go first.
+func (s *server) ListDrafts(ctx context.Context, req *pb.TListDraftsRequest) (*pb.TGetPostsOnPageResponse, error) {
+	posts, err := queryPosts(
+		ctx,
+		"SELECT "+postColumns+` FROM POSTS AS p
+		WHERE p.AuthorLogin = $1 AND p.Status <> 'PUBLISHED' AND p.DeletedAt IS NULL
+		ORDER BY p.PublishAt NULLS LAST, p.PostId DESC`,
+		req.AuthorLogin,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TGetPostsOnPageResponse{Posts: posts}, nil
+}
+
+// Publishes scheduled posts that are due. Rows are locked with SKIP LOCKED, so replicas share the work
+// instead of publishing the same post twice. Events are stored with the batch and relayed once it is committed.
+func PublishDuePosts(ctx context.Context) (int, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(
+		ctx,
+		`UPDATE POSTS SET Status = 'PUBLISHED'
+		WHERE PostId IN (
+			SELECT PostId FROM POSTS
+			WHERE Status = 'SCHEDULED' AND PublishAt <= now() AND DeletedAt IS NULL
+			ORDER BY PublishAt
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING PostId, AuthorLogin, Visibility, Mentions`,
+		publishBatchSize,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var events []*pb.TPostEvent
+	var mentions [][]string
+	for rows.Next() {
+		event := &pb.TPostEvent{Type: pb.TPostEvent_PUBLISHED}
+		var visibility string
+		var postMentions []string
+		if err := rows.Scan(&event.PostId, &event.AuthorLogin, &visibility, pq.Array(&postMentions)); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		event.Visibility = pb.TPost_EVisibility(pb.TPost_EVisibility_value[visibility])
+		events = append(events, event)
+		mentions = append(mentions, postMentions)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for i, event := range events {
+		if err := enqueuePostEvent(ctx, tx, event); err != nil {
+			return 0, err
+		}
+		if err := notifyMentions(ctx, tx, event.PostId, mentions[i]); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
+
+// Publishes due posts on every tick and relays the stored post events after that. A commit that stored events
+// wakes the loop to relay them without waiting for the tick.
+func RunPublishLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			publishDuePosts()
+		case <-relayWakeup:
+		}
+		relayPendingEvents()
+	}
+}
+
+func publishDuePosts() {
+	for {
+		published, err := PublishDuePosts(context.Background())
+		if err != nil {
+			log.Printf("failed to publish scheduled posts: %v", err)
+			return
+		}
+		if published > 0 {
+			log.Printf("Published %d scheduled posts", published)
+		}
+		if published < publishBatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+const exifOrientationTag = 0x0112
+
+// Reads the EXIF orientation of a JPEG file, 1 means the pixels are stored upright.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		// start of scan, metadata segments are over
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	entries := int(order.Uint16(tiff[ifd:]))
+	for n := 0; n < entries; n++ {
+		entry := ifd + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == exifOrientationTag {
+			if orientation := int(order.Uint16(tiff[entry+8:])); orientation >= 1 && orientation <= 8 {
+				return orientation
+			}
+			return 1
+		}
+	}
+	return 1
+}
+
+// Flips and rotates the image as prescribed by the EXIF orientation.
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	// maps a pixel of the upright image onto the stored one
+	var source func(x, y int) (int, int)
+	switch orientation {
+	case 2:
+		source = func(x, y int) (int, int) { return width - 1 - x, y }
+	case 3:
+		source = func(x, y int) (int, int) { return width - 1 - x, height - 1 - y }
+	case 4:
+		source = func(x, y int) (int, int) { return x, height - 1 - y }
+	case 5:
+		source = func(x, y int) (int, int) { return y, x }
+	case 6:
+		source = func(x, y int) (int, int) { return y, height - 1 - x }
+	case 7:
+		source = func(x, y int) (int, int) { return width - 1 - y, height - 1 - x }
+	case 8:
+		source = func(x, y int) (int, int) { return width - 1 - y, x }
+	}
+
+	rotated := image.NewRGBA(image.Rect(0, 0, width, height))
+	if orientation >= 5 {
+		rotated = image.NewRGBA(image.Rect(0, 0, height, width))
+	}
+	for y := 0; y < rotated.Bounds().Dy(); y++ {
+		for x := 0; x < rotated.Bounds().Dx(); x++ {
+			sx, sy := source(x, y)
+			rotated.Set(x, y, img.At(bounds.Min.X+sx, bounds.Min.Y+sy))
+		}
+	}
+	return rotated
+}
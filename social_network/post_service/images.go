@@ -0,0 +1,440 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log"
+	"sort"
+	"time"
+
+	"blobstore"
+	pb "proto"
+
+	"github.com/IBM/sarama"
+	"github.com/buckket/go-blurhash"
+	"github.com/lib/pq"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+	"google.golang.org/protobuf/proto"
+)
+
+const ImagesTopic = "ImagesTopic"
+
+// Larger images are rejected before decoding, so that a small file can not exhaust the worker's memory
+const maxImagePixels = 50_000_000
+
+// Returned for images that will never be processed, those are not retried
+var errBadImage = errors.New("bad image")
+
+type TImageVariantSpec struct {
+	Name pb.TImageVariant_EName
+	// Longest side of the variant, 0 keeps the original size
+	MaxSide int
+}
+
+var imageVariantSpecs = []TImageVariantSpec{
+	{Name: pb.TImageVariant_ORIGINAL},
+	{Name: pb.TImageVariant_MEDIUM, MaxSide: 1280},
+	{Name: pb.TImageVariant_THUMBNAIL, MaxSide: 256},
+}
+
+var imageDecoders = map[string]func(io.Reader) (image.Image, error){
+	"image/jpeg": jpeg.Decode,
+	"image/png":  png.Decode,
+	"image/gif":  gif.Decode,
+	"image/webp": webp.Decode,
+}
+
+var imageConfigDecoders = map[string]func(io.Reader) (image.Config, error){
+	"image/jpeg": jpeg.DecodeConfig,
+	"image/png":  png.DecodeConfig,
+	"image/gif":  gif.DecodeConfig,
+	"image/webp": webp.DecodeConfig,
+}
+
+func isProcessableImage(contentType string) bool {
+	_, ok := imageDecoders[contentType]
+	return ok
+}
+
+func variantBlobKey(attachmentId uint64, name pb.TImageVariant_EName) string {
+	return fmt.Sprintf("variants/%d/%s", attachmentId, name)
+}
+
+func insertPendingVariants(ctx context.Context, tx *sql.Tx, attachmentId uint64) ([]*pb.TImageVariant, error) {
+	var variants []*pb.TImageVariant
+	for _, spec := range imageVariantSpecs {
+		_, err := tx.ExecContext(ctx, "INSERT INTO ATTACHMENT_VARIANTS (AttachmentId, Name) VALUES ($1, $2)", attachmentId, spec.Name.String())
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, &pb.TImageVariant{Name: spec.Name, State: pb.TImageVariant_PENDING})
+	}
+	return variants, nil
+}
+
+func loadImageVariants(ctx context.Context, attachments []*pb.TAttachment) error {
+	if len(attachments) == 0 {
+		return nil
+	}
+	byId := make(map[uint64]*pb.TAttachment, len(attachments))
+	ids := make([]int64, 0, len(attachments))
+	for _, attachment := range attachments {
+		byId[attachment.AttachmentId] = attachment
+		ids = append(ids, int64(attachment.AttachmentId))
+	}
+
+	rows, err := db.QueryContext(
+		ctx,
+		"SELECT AttachmentId, Name, State, Width, Height, Size, ContentType, BlobKey FROM ATTACHMENT_VARIANTS WHERE AttachmentId = ANY($1)",
+		pq.Array(ids),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var attachmentId uint64
+		var name, state string
+		var variant pb.TImageVariant
+		err := rows.Scan(&attachmentId, &name, &state, &variant.Width, &variant.Height, &variant.Size, &variant.ContentType, &variant.BlobKey)
+		if err != nil {
+			return err
+		}
+		variant.Name = pb.TImageVariant_EName(pb.TImageVariant_EName_value[name])
+		variant.State = pb.TImageVariant_EState(pb.TImageVariant_EState_value[state])
+		if attachment, ok := byId[attachmentId]; ok {
+			attachment.Variants = append(attachment.Variants, &variant)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		variants := attachment.Variants
+		sort.Slice(variants, func(i, j int) bool { return variants[i].Name < variants[j].Name })
+	}
+	return nil
+}
+
+// Called after the attachment is committed. If the job can not be sent, variants are marked as failed
+// instead of staying pending forever.
+func enqueueImageJob(ctx context.Context, attachment *pb.TAttachment) {
+	err := publishImageJob(&pb.TImageJob{
+		AttachmentId: attachment.AttachmentId,
+		BlobKey:      attachment.BlobKey,
+		ContentType:  attachment.ContentType,
+	})
+	if err == nil {
+		return
+	}
+	log.Printf("failed to enqueue processing of attachment %d: %v", attachment.AttachmentId, err)
+	markImageFailed(ctx, attachment.AttachmentId)
+	for _, variant := range attachment.Variants {
+		variant.State = pb.TImageVariant_FAILED
+	}
+}
+
+func publishImageJob(job *pb.TImageJob) error {
+	serializedJob, err := proto.Marshal(job)
+	if err != nil {
+		return err
+	}
+	_, _, err = kafkaProducer.SendMessage(&sarama.ProducerMessage{Topic: ImagesTopic, Value: sarama.ByteEncoder(serializedJob)})
+	return err
+}
+
+func markImageFailed(ctx context.Context, attachmentId uint64) {
+	_, err := db.ExecContext(
+		ctx,
+		"UPDATE ATTACHMENT_VARIANTS SET State = 'FAILED', UpdatedAt = now() WHERE AttachmentId = $1 AND State = 'PENDING'",
+		attachmentId,
+	)
+	if err != nil {
+		log.Printf("failed to mark attachment %d as failed: %v", attachmentId, err)
+	}
+}
+
+type TProcessedVariant struct {
+	Variant *pb.TImageVariant
+	Image   image.Image
+}
+
+// Decodes the uploaded image and stores its variants. Re-encoding drops EXIF and any other metadata,
+// so the camera orientation is applied to the pixels beforehand.
+func ProcessImage(ctx context.Context, job *pb.TImageJob) error {
+	decode, ok := imageDecoders[job.ContentType]
+	if !ok {
+		return fmt.Errorf("%w: unsupported content type %v", errBadImage, job.ContentType)
+	}
+
+	blob, err := blobStore.Get(ctx, job.BlobKey)
+	if errors.Is(err, blobstore.ErrNotFound) {
+		return fmt.Errorf("%w: %v", errBadImage, err)
+	}
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(blob)
+	blob.Close()
+	if err != nil {
+		return err
+	}
+
+	config, err := imageConfigDecoders[job.ContentType](bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("%w: %v", errBadImage, err)
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return fmt.Errorf("%w: image is too large: %dx%d", errBadImage, config.Width, config.Height)
+	}
+	source, err := decode(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("%w: %v", errBadImage, err)
+	}
+	if job.ContentType == "image/jpeg" {
+		source = applyOrientation(source, jpegOrientation(data))
+	}
+	opaque := isOpaque(source)
+
+	var processed []TProcessedVariant
+	for _, spec := range imageVariantSpecs {
+		img := resizeToFit(source, spec.MaxSide)
+		variant, err := storeVariant(ctx, job.AttachmentId, spec.Name, img, opaque)
+		if err != nil {
+			return err
+		}
+		processed = append(processed, TProcessedVariant{Variant: variant, Image: img})
+	}
+
+	// the smallest variant is plenty for a blurred placeholder
+	hash, err := blurhash.Encode(4, 3, processed[len(processed)-1].Image)
+	if err != nil {
+		return err
+	}
+
+	saved, err := saveProcessedImage(ctx, job.AttachmentId, source.Bounds(), hash, processed)
+	if err != nil {
+		return err
+	}
+	if !saved {
+		// the attachment was deleted while it was processed
+		var keys []string
+		for _, p := range processed {
+			keys = append(keys, p.Variant.BlobKey)
+		}
+		deleteBlobs(ctx, keys)
+	}
+	return nil
+}
+
+func storeVariant(ctx context.Context, attachmentId uint64, name pb.TImageVariant_EName, img image.Image, opaque bool) (*pb.TImageVariant, error) {
+	var encoded bytes.Buffer
+	contentType := "image/png"
+	var err error
+	if opaque {
+		contentType = "image/jpeg"
+		err = jpeg.Encode(&encoded, img, &jpeg.Options{Quality: 85})
+	} else {
+		err = png.Encode(&encoded, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	variant := &pb.TImageVariant{
+		Name:        name,
+		State:       pb.TImageVariant_READY,
+		Width:       uint32(img.Bounds().Dx()),
+		Height:      uint32(img.Bounds().Dy()),
+		Size:        uint64(encoded.Len()),
+		ContentType: contentType,
+		BlobKey:     variantBlobKey(attachmentId, name),
+	}
+	if err := blobStore.Put(ctx, variant.BlobKey, &encoded, int64(encoded.Len()), contentType); err != nil {
+		return nil, err
+	}
+	return variant, nil
+}
+
+// Returns false if the attachment no longer exists.
+func saveProcessedImage(ctx context.Context, attachmentId uint64, bounds image.Rectangle, hash string, processed []TProcessedVariant) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(
+		ctx,
+		"UPDATE ATTACHMENTS SET Width = $1, Height = $2, BlurHash = $3 WHERE AttachmentId = $4",
+		bounds.Dx(),
+		bounds.Dy(),
+		hash,
+		attachmentId,
+	)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	for _, p := range processed {
+		variant := p.Variant
+		_, err := tx.ExecContext(
+			ctx,
+			`UPDATE ATTACHMENT_VARIANTS SET State = $1, Width = $2, Height = $3, Size = $4, ContentType = $5, BlobKey = $6, UpdatedAt = now()
+			WHERE AttachmentId = $7 AND Name = $8`,
+			variant.State.String(),
+			variant.Width,
+			variant.Height,
+			variant.Size,
+			variant.ContentType,
+			variant.BlobKey,
+			attachmentId,
+			variant.Name.String(),
+		)
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, tx.Commit()
+}
+
+func resizeToFit(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if maxSide == 0 || max(width, height) <= maxSide {
+		return img
+	}
+	if width >= height {
+		height = max(1, height*maxSide/width)
+		width = maxSide
+	} else {
+		width = max(1, width*maxSide/height)
+		height = maxSide
+	}
+	resized := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(resized, resized.Bounds(), img, bounds, draw.Src, nil)
+	return resized
+}
+
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
+
+const imageWorkerGroup = "post_service.images"
+
+// Jobs that fail are retried with exponential backoff, then the variants are marked as failed
+const (
+	imageJobAttempts     = 5
+	imageRetryBackoff    = time.Second
+	maxImageRetryBackoff = 30 * time.Second
+)
+
+// Replicas share the jobs as members of one consumer group. A job is committed once its variants are stored or
+// marked as failed, so jobs sent while no worker was running are picked up on start.
+func RunImageWorker() {
+	config := sarama.NewConfig()
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Offsets.AutoCommit.Enable = false
+	group, err := sarama.NewConsumerGroup([]string{"kafka:9092"}, imageWorkerGroup, config)
+	if err != nil {
+		log.Fatalf("failed to create kafka consumer group: %v", err)
+	}
+	defer group.Close()
+
+	// Consume returns on every rebalance and is called again to rejoin the group
+	for {
+		if err := group.Consume(context.Background(), []string{ImagesTopic}, imageWorker{}); err != nil {
+			log.Printf("image worker failed: %v", err)
+			time.Sleep(imageRetryBackoff)
+		}
+	}
+}
+
+type imageWorker struct{}
+
+func (imageWorker) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (imageWorker) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (imageWorker) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		var job pb.TImageJob
+		if err := proto.Unmarshal(msg.Value, &job); err != nil {
+			log.Printf("failed to unmarshal image job at offset %d: %v", msg.Offset, err)
+		} else if !processImageJob(session.Context(), &job) {
+			// the partition was taken away, the job is processed by its next owner
+			return nil
+		}
+		session.MarkMessage(msg, "")
+		session.Commit()
+	}
+	return nil
+}
+
+// Returns false if ctx is done before the job is processed or marked as failed.
+func processImageJob(ctx context.Context, job *pb.TImageJob) bool {
+	backoff := imageRetryBackoff
+	for attempt := 1; ; attempt++ {
+		pending, err := isImagePending(ctx, job.AttachmentId)
+		if err == nil && !pending {
+			// processed before a redelivery, or deleted
+			return true
+		}
+		if err == nil {
+			err = ProcessImage(ctx, job)
+		}
+		if err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		if errors.Is(err, errBadImage) || attempt >= imageJobAttempts {
+			log.Printf("failed to process attachment %d: %v", job.AttachmentId, err)
+			markImageFailed(ctx, job.AttachmentId)
+			return true
+		}
+		log.Printf("failed to process attachment %d, attempt %d: %v", job.AttachmentId, attempt, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxImageRetryBackoff)
+	}
+}
+
+func isImagePending(ctx context.Context, attachmentId uint64) (bool, error) {
+	var pending bool
+	err := db.QueryRowContext(
+		ctx,
+		"SELECT EXISTS (SELECT 1 FROM ATTACHMENT_VARIANTS WHERE AttachmentId = $1 AND State = 'PENDING')",
+		attachmentId,
+	).Scan(&pending)
+	return pending, err
+}
@@ -3,19 +3,24 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"time"
 
+	"blobstore"
 	pb "proto"
 
+	"github.com/IBM/sarama"
+	"github.com/lib/pq"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
-
-	_ "github.com/lib/pq"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type server struct {
@@ -36,99 +41,310 @@ func ConnectDB() (*sql.DB, error) {
 		return nil, err
 	}
 
-	_, err = db.Exec(`
-	CREATE TABLE IF NOT EXISTS POSTS (
-		PostId SERIAL PRIMARY KEY,
-		Title TEXT,
-		Content TEXT,
-		AuthorLogin TEXT
-	);
-	`)
+	return db, nil
+}
+
+func (s *server) CreatePost(ctx context.Context, request *pb.TCreatePostRequest) (*pb.TCreatePostResponse, error) {
+	var publishAt sql.NullTime
+	switch request.Status {
+	case pb.TPost_PUBLISHED:
+		publishAt = sql.NullTime{Time: time.Now(), Valid: true}
+	case pb.TPost_SCHEDULED:
+		if request.PublishAt == nil || !request.PublishAt.AsTime().After(time.Now()) {
+			return &pb.TCreatePostResponse{}, status.Errorf(codes.InvalidArgument, "scheduled posts require publish time in the future")
+		}
+		publishAt = sql.NullTime{Time: request.PublishAt.AsTime(), Valid: true}
+	}
+	if err := validatePoll(request); err != nil {
+		return &pb.TCreatePostResponse{}, err
+	}
 
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
+		return &pb.TCreatePostResponse{}, status.Errorf(codes.Internal, "failed to create post: %v", err)
 	}
+	defer tx.Rollback()
 
-	return db, nil
-}
+	refPostId, refAuthor, err := resolveReference(ctx, tx, request)
+	if err != nil {
+		return &pb.TCreatePostResponse{}, err
+	}
 
-func (s *server) CreatePost(ctx context.Context, request *pb.TCreatePostRequest) (*pb.TCreatePostResponse, error) {
 	var postId uint64
-	err := db.QueryRowContext(
+	err = tx.QueryRowContext(
 		ctx,
-		"INSERT INTO POSTS (Title, Content, AuthorLogin) VALUES ($1, $2, $3) RETURNING PostId",
+		"INSERT INTO POSTS (Title, Content, AuthorLogin, Status, PublishAt, Visibility, Kind, RefPostId, Mentions) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, COALESCE($9::TEXT[], '{}')) RETURNING PostId",
 		request.Title,
 		request.Content,
 		request.AuthorLogin,
+		request.Status.String(),
+		publishAt,
+		request.Visibility.String(),
+		request.Kind.String(),
+		sql.NullInt64{Int64: int64(refPostId), Valid: refPostId != 0},
+		pq.Array(request.Mentions),
 	).Scan(&postId)
+	if isUniqueViolation(err) {
+		return &pb.TCreatePostResponse{}, status.Errorf(codes.AlreadyExists, "post is already reposted")
+	}
 	if err != nil {
 		return &pb.TCreatePostResponse{}, status.Errorf(codes.Internal, "failed to create post: %v", err)
 	}
+	if _, err := insertRevision(ctx, tx, postId, request.AuthorLogin); err != nil {
+		return &pb.TCreatePostResponse{}, status.Errorf(codes.Internal, "failed to save post revision: %v", err)
+	}
+	if request.Poll != nil {
+		if err := insertPoll(ctx, tx, postId, request.Poll); err != nil {
+			return &pb.TCreatePostResponse{}, status.Errorf(codes.Internal, "failed to save poll: %v", err)
+		}
+	}
+	if err := syncPostTags(ctx, tx, postId, request.AuthorLogin); err != nil {
+		return &pb.TCreatePostResponse{}, status.Errorf(codes.Internal, "failed to save post tags: %v", err)
+	}
+	if request.Status == pb.TPost_PUBLISHED {
+		if err := enqueuePostPublished(ctx, tx, postId, request.AuthorLogin, request.Visibility); err != nil {
+			return &pb.TCreatePostResponse{}, status.Errorf(codes.Internal, "failed to create post: %v", err)
+		}
+		if err := notifyMentions(ctx, tx, postId, request.Mentions); err != nil {
+			return &pb.TCreatePostResponse{}, status.Errorf(codes.Internal, "failed to notify mentioned users: %v", err)
+		}
+	}
+	if refPostId != 0 {
+		if err := recordReference(ctx, tx, postId, request, refPostId, refAuthor); err != nil {
+			return &pb.TCreatePostResponse{}, status.Errorf(codes.Internal, "failed to share post: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return &pb.TCreatePostResponse{}, status.Errorf(codes.Internal, "failed to create post: %v", err)
+	}
+	wakeRelay()
 	return &pb.TCreatePostResponse{PostId: &postId}, nil
 }
 
-func (s *server) UpdatePost(ctx context.Context, req *pb.TUpdatePostRequest) (*emptypb.Empty, error) {
-	result, err := db.ExecContext(
+func (s *server) UpdatePost(ctx context.Context, req *pb.TUpdatePostRequest) (*pb.TUpdatePostResponse, error) {
+	if req.ExpectedVersion == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "expected version is required")
+	}
+
+	updateTitle, updateContent, updateVisibility := true, true, false
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		updateTitle, updateContent = false, false
+		for _, path := range paths {
+			switch path {
+			case "Title":
+				updateTitle = true
+			case "Content":
+				updateContent = true
+			case "Visibility":
+				updateVisibility = true
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "unknown update mask path %q", path)
+			}
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update post: %v", err)
+	}
+	defer tx.Rollback()
+
+	var version uint64
+	var oldMentions []string
+	// mentions follow the content, the old ones are returned to notify only the newly mentioned users
+	err = tx.QueryRowContext(
 		ctx,
-		"UPDATE POSTS SET Title = $1, Content = $2 WHERE PostId = $3 and AuthorLogin = $4",
+		`UPDATE POSTS SET
+			Title = CASE WHEN $6 THEN $1 ELSE Title END,
+			Content = CASE WHEN $7 THEN $2 ELSE Content END,
+			Visibility = CASE WHEN $9 THEN $8 ELSE Visibility END,
+			Mentions = CASE WHEN $7 THEN COALESCE($10::TEXT[], '{}') ELSE Mentions END,
+			Version = Version + 1
+		FROM (SELECT Mentions AS OldMentions FROM POSTS WHERE PostId = $3) AS old
+		WHERE PostId = $3 and AuthorLogin = $4 and Version = $5 and DeletedAt IS NULL and Kind <> 'REPOST'
+		RETURNING Version, old.OldMentions`,
 		req.Title,
 		req.Content,
 		req.PostId,
 		req.AuthorLogin,
-	)
-	if err != nil {
-		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to update post: %v", err)
+		req.ExpectedVersion,
+		updateTitle,
+		updateContent,
+		req.Visibility.String(),
+		updateVisibility,
+		pq.Array(req.Mentions),
+	).Scan(&version, pq.Array(&oldMentions))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, explainUpdateFailure(ctx, tx, req.PostId, req.AuthorLogin, req.ExpectedVersion)
 	}
-	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to update post: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update post: %v", err)
+	}
+	if _, err := insertRevision(ctx, tx, req.PostId, req.AuthorLogin); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save post revision: %v", err)
+	}
+	if err := syncPostTags(ctx, tx, req.PostId, req.AuthorLogin); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save post tags: %v", err)
 	}
-	if rowsAffected == 0 {
-		return &emptypb.Empty{}, status.Errorf(codes.PermissionDenied, "author change is restricted")
+	if updateVisibility {
+		if err := enqueueVisibilityChanged(ctx, tx, req.PostId, req.AuthorLogin, req.Visibility); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update post: %v", err)
+		}
+	}
+	if updateContent {
+		if err := notifyMentions(ctx, tx, req.PostId, addedMentions(oldMentions, req.Mentions)); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to notify mentioned users: %v", err)
+		}
 	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update post: %v", err)
+	}
+	wakeRelay()
 
-	return &emptypb.Empty{}, nil
+	return &pb.TUpdatePostResponse{Version: version}, nil
 }
 
-func (s *server) DeletePost(ctx context.Context, req *pb.TDeletePostRequest) (*emptypb.Empty, error) {
-	result, err := db.ExecContext(ctx, "DELETE FROM POSTS WHERE PostId = $1 and AuthorLogin = $2", req.PostId, req.AuthorLogin)
+// Tells apart the reasons a conditional update matched no rows.
+func explainUpdateFailure(ctx context.Context, tx *sql.Tx, postId uint64, authorLogin string, expectedVersion uint64) error {
+	var actualAuthor, kind string
+	var actualVersion uint64
+	err := tx.QueryRowContext(
+		ctx,
+		"SELECT AuthorLogin, Version, Kind FROM POSTS WHERE PostId = $1 and DeletedAt IS NULL",
+		postId,
+	).Scan(&actualAuthor, &actualVersion, &kind)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "post not found")
+	}
 	if err != nil {
-		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to update post: %v", err)
+		return status.Errorf(codes.Internal, "failed to update post: %v", err)
+	}
+	if actualAuthor != authorLogin {
+		return status.Errorf(codes.PermissionDenied, "author change is restricted")
+	}
+	if kind == pb.TPost_REPOST.String() {
+		return status.Errorf(codes.FailedPrecondition, "reposts can not be edited")
 	}
+	return status.Errorf(codes.Aborted, "post version is %d, but %d expected", actualVersion, expectedVersion)
+}
 
-	rowsAffected, err := result.RowsAffected()
+// Deleted posts are moved to the author's trash and purged after the retention period.
+func (s *server) DeletePost(ctx context.Context, req *pb.TDeletePostRequest) (*emptypb.Empty, error) {
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to delete post: %v", err)
 	}
+	defer tx.Rollback()
 
-	if rowsAffected == 0 {
-		result, _ := db.ExecContext(ctx, "SELECT FROM POSTS WHERE PostId = $1", req.PostId)
-		rowsAffected, _ := result.RowsAffected()
-		if rowsAffected != 0 {
+	var postStatus string
+	err = tx.QueryRowContext(
+		ctx,
+		"UPDATE POSTS SET DeletedAt = now() WHERE PostId = $1 and AuthorLogin = $2 and DeletedAt IS NULL RETURNING Status",
+		req.PostId,
+		req.AuthorLogin,
+	).Scan(&postStatus)
+	if errors.Is(err, sql.ErrNoRows) {
+		var authorLogin string
+		err := tx.QueryRowContext(ctx, "SELECT AuthorLogin FROM POSTS WHERE PostId = $1 and DeletedAt IS NULL", req.PostId).Scan(&authorLogin)
+		if err == nil && authorLogin != req.AuthorLogin {
 			return &emptypb.Empty{}, status.Errorf(codes.PermissionDenied, "you are not the author of this post")
 		}
 		return &emptypb.Empty{}, status.Errorf(codes.NotFound, "post not found")
 	}
+	if err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to delete post: %v", err)
+	}
+	if err := enqueueTrashVisibility(ctx, tx, req.PostId, req.AuthorLogin, postStatus, pb.TPost_PRIVATE); err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to delete post: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to delete post: %v", err)
+	}
+	wakeRelay()
 
 	return &emptypb.Empty{}, nil
 }
 
-func (s *server) GetPostById(ctx context.Context, req *pb.TGetPostByIdRequest) (*pb.TGetPostByIdResponse, error) {
+const PageSize = 10
+
+// Columns read by scanPost, POSTS must be aliased as p
+const postColumns = "p.PostId, p.Title, p.Content, p.AuthorLogin, p.Version, p.CreatedAt, p.Status, p.PublishAt, p.Visibility, p.Kind, p.RefPostId, p.Mentions"
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// Scans postColumns followed by the extra destinations.
+func scanPost(row rowScanner, extra ...any) (*pb.TPost, error) {
 	var post pb.TPost
-	err := db.QueryRowContext(
+	var createdAt time.Time
+	var postStatus string
+	var publishAt sql.NullTime
+	var visibility, kind string
+	var refPostId sql.NullInt64
+	dest := append([]any{&post.PostId, &post.Title, &post.Content, &post.AuthorLogin, &post.Version, &createdAt, &postStatus, &publishAt, &visibility, &kind, &refPostId, pq.Array(&post.Mentions)}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	post.CreatedAt = timestamppb.New(createdAt)
+	post.Status = pb.TPost_EStatus(pb.TPost_EStatus_value[postStatus])
+	post.Visibility = pb.TPost_EVisibility(pb.TPost_EVisibility_value[visibility])
+	post.Kind = pb.TPost_EKind(pb.TPost_EKind_value[kind])
+	if refPostId.Valid {
+		post.Reference = &pb.TPostReference{PostId: uint64(refPostId.Int64)}
+	}
+	if publishAt.Valid {
+		post.PublishAt = timestamppb.New(publishAt.Time)
+	}
+	return &post, nil
+}
+
+func (s *server) GetPostById(ctx context.Context, req *pb.TGetPostByIdRequest) (*pb.TGetPostByIdResponse, error) {
+	post, err := scanPost(db.QueryRowContext(
 		ctx,
-		"SELECT PostId, Title, Content, AuthorLogin FROM POSTS WHERE PostId = $1",
+		"SELECT "+postColumns+" FROM POSTS AS p WHERE p.PostId = $1 AND "+visibleToCondition("$2"),
 		req.PostId,
-	).Scan(&post.PostId, &post.Title, &post.Content, &post.AuthorLogin)
+		req.ViewerLogin,
+	))
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "post not found")
 	}
+	if err := loadTags(ctx, []*pb.TPost{post}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch tags: %v", err)
+	}
+	if err := loadAttachments(ctx, []*pb.TPost{post}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch attachments: %v", err)
+	}
+	if err := loadPolls(ctx, []*pb.TPost{post}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch poll: %v", err)
+	}
+	if err := loadReferences(ctx, []*pb.TPost{post}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch shared posts: %v", err)
+	}
 
-	return &pb.TGetPostByIdResponse{Post: &post}, nil
+	return &pb.TGetPostByIdResponse{Post: post}, nil
 }
 
 func (s *server) GetPostsOnPage(ctx context.Context, req *pb.TGetPostsOnPageRequest) (*pb.TGetPostsOnPageResponse, error) {
-	rows, err := db.QueryContext(ctx, "SELECT PostId, Title, Content, AuthorLogin FROM POSTS LIMIT $1 OFFSET $2", 10, req.PageId*10)
+	posts, err := queryPosts(
+		ctx,
+		"SELECT "+postColumns+" FROM POSTS AS p WHERE "+listedForCondition("$3")+" ORDER BY p.PostId LIMIT $1 OFFSET $2",
+		PageSize,
+		req.PageId*PageSize,
+		req.ViewerLogin,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TGetPostsOnPageResponse{
+		Posts: posts,
+	}, nil
+}
+
+// Runs a query selecting postColumns and fills in tags, attachments, polls and shared posts of the found posts.
+func queryPosts(ctx context.Context, query string, args ...any) ([]*pb.TPost, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to fetch posts: %v", err)
 	}
@@ -136,23 +352,44 @@ func (s *server) GetPostsOnPage(ctx context.Context, req *pb.TGetPostsOnPageRequ
 
 	var posts []*pb.TPost
 	for rows.Next() {
-		var post pb.TPost
-		if err := rows.Scan(&post.PostId, &post.Title, &post.Content, &post.AuthorLogin); err != nil {
+		post, err := scanPost(rows)
+		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan post: %v", err)
 		}
-		posts = append(posts, &post)
+		posts = append(posts, post)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, status.Errorf(codes.Internal, "error iterating over rows: %v", err)
 	}
+	if err := loadTags(ctx, posts); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch tags: %v", err)
+	}
+	if err := loadAttachments(ctx, posts); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch attachments: %v", err)
+	}
+	if err := loadPolls(ctx, posts); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch polls: %v", err)
+	}
+	if err := loadReferences(ctx, posts); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch shared posts: %v", err)
+	}
 
-	return &pb.TGetPostsOnPageResponse{
-		Posts: posts,
-	}, nil
+	return posts, nil
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(RunMigrateCommand(os.Args[2:]))
+	}
+
+	flag.DurationVar(&trashRetention, "trash_retention", 30*24*time.Hour, "how long deleted posts stay in trash")
+	purgeInterval := flag.Duration("purge_interval", time.Hour, "how often expired posts are purged")
+	publishInterval := flag.Duration("publish_interval", 10*time.Second, "how often scheduled posts are checked for being due")
+	var blobConfig blobstore.TConfig
+	blobConfig.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
 	listenAddress := ":50051"
 	lis, err := net.Listen("tcp", listenAddress)
 	if err != nil {
@@ -167,6 +404,28 @@ func main() {
 	}
 	defer db.Close()
 
+	if err := MigrateUp(context.Background(), db, 0); err != nil {
+		log.Printf("failed to migrate database: %v", err)
+		os.Exit(1)
+	}
+
+	blobStore, err = blobstore.New(context.Background(), blobConfig)
+	if err != nil {
+		log.Printf("failed to create blob store: %v", err)
+		os.Exit(1)
+	}
+
+	kafkaProducer, err = sarama.NewSyncProducer([]string{"kafka:9092"}, nil)
+	if err != nil {
+		log.Printf("failed to create kafka producer: %v", err)
+		os.Exit(1)
+	}
+	defer kafkaProducer.Close()
+
+	go RunPurgeLoop(*purgeInterval)
+	go RunPublishLoop(*publishInterval)
+	go RunImageWorker()
+
 	serverInstance := grpc.NewServer()
 	pb.RegisterPostServiceServer(serverInstance, &server{})
 
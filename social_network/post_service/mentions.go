@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	pb "proto"
+
+	"github.com/lib/pq"
+)
+
+// Notifies mentioned users who can see the published post, the author is never notified. The MENTIONED event
+// is stored with the notifications and relayed once the transaction commits.
+func notifyMentions(ctx context.Context, tx *sql.Tx, postId uint64, logins []string) error {
+	if len(logins) == 0 {
+		return nil
+	}
+	rows, err := tx.QueryContext(
+		ctx,
+		`INSERT INTO NOTIFICATIONS (RecipientLogin, Type, ActorLogin, PostId)
+		SELECT m.Login, 'MENTIONED', p.AuthorLogin, p.PostId
+		FROM POSTS AS p, unnest($2::TEXT[]) AS m(Login)
+		WHERE p.PostId = $1 AND p.Status = 'PUBLISHED' AND m.Login <> p.AuthorLogin AND `+visibleToCondition("m.Login")+`
+		RETURNING RecipientLogin, ActorLogin`,
+		postId,
+		pq.Array(logins),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	event := &pb.TPostEvent{Type: pb.TPostEvent_MENTIONED, PostId: postId}
+	for rows.Next() {
+		var recipient string
+		if err := rows.Scan(&recipient, &event.AuthorLogin); err != nil {
+			return err
+		}
+		event.Mentions = append(event.Mentions, recipient)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(event.Mentions) == 0 {
+		return nil
+	}
+	return enqueuePostEvent(ctx, tx, event)
+}
+
+// Returns the mentions that were not there before an edit, only those are notified again.
+func addedMentions(before []string, after []string) []string {
+	var added []string
+	for _, login := range after {
+		if !slices.Contains(before, login) {
+			added = append(added, login)
+		}
+	}
+	return added
+}
+
+func (s *server) ListMentions(ctx context.Context, req *pb.TListMentionsRequest) (*pb.TGetPostsOnPageResponse, error) {
+	posts, err := queryPosts(
+		ctx,
+		"SELECT "+postColumns+` FROM POSTS AS p
+		WHERE p.Mentions @> ARRAY[$1::TEXT] AND p.Status = 'PUBLISHED' AND `+visibleToCondition("$1")+`
+		ORDER BY p.PostId DESC
+		LIMIT $2 OFFSET $3`,
+		req.Login,
+		PageSize,
+		req.PageId*PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TGetPostsOnPageResponse{Posts: posts}, nil
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// Arbitrary key shared by all post_service replicas, so only one of them migrates at a time.
+const migrationLockKey int64 = 0x706f737473
+
+type TMigration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type TMigrationStatus struct {
+	Migration TMigration
+	Applied   bool
+}
+
+// Migrations are stored as `<version>_<name>.up.sql` and `<version>_<name>.down.sql`.
+func LoadMigrations() ([]TMigration, error) {
+	entries, err := fs.ReadDir(migrationsFS, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*TMigration{}
+	for _, entry := range entries {
+		fileName := entry.Name()
+		var direction string
+		switch {
+		case strings.HasSuffix(fileName, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(fileName, ".down.sql"):
+			direction = "down"
+		default:
+			return nil, fmt.Errorf("unexpected migration file %v", fileName)
+		}
+
+		base := strings.TrimSuffix(fileName, "."+direction+".sql")
+		versionStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("migration file %v has no name", fileName)
+		}
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version in migration file %v: %v", fileName, err)
+		}
+
+		body, err := migrationsFS.ReadFile("migrations/" + fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &TMigration{Version: version, Name: name}
+			byVersion[version] = m
+		}
+		if m.Name != name {
+			return nil, fmt.Errorf("migration %d has conflicting names %v and %v", version, m.Name, name)
+		}
+		if direction == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]TMigration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%v has no up script", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Runs fn on a single connection holding the migration advisory lock.
+func withMigrationLock(ctx context.Context, db *sql.DB, fn func(conn *sql.Conn) error) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %v", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockKey); err != nil {
+			log.Printf("failed to release migration lock: %v", err)
+		}
+	}()
+
+	_, err = conn.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS SCHEMA_MIGRATIONS (
+		Version BIGINT PRIMARY KEY,
+		Name TEXT NOT NULL,
+		AppliedAt TIMESTAMPTZ NOT NULL DEFAULT now()
+	);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %v", err)
+	}
+
+	return fn(conn)
+}
+
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]bool, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT Version FROM SCHEMA_MIGRATIONS")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int64]bool{}
+	for rows.Next() {
+		var version int64
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+	return applied, rows.Err()
+}
+
+func applyMigration(ctx context.Context, conn *sql.Conn, m TMigration, up bool) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if up {
+		if _, err := tx.ExecContext(ctx, m.Up); err != nil {
+			return fmt.Errorf("migration %d_%v up failed: %v", m.Version, m.Name, err)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO SCHEMA_MIGRATIONS (Version, Name) VALUES ($1, $2)", m.Version, m.Name); err != nil {
+			return err
+		}
+	} else {
+		if m.Down == "" {
+			return fmt.Errorf("migration %d_%v has no down script", m.Version, m.Name)
+		}
+		if _, err := tx.ExecContext(ctx, m.Down); err != nil {
+			return fmt.Errorf("migration %d_%v down failed: %v", m.Version, m.Name, err)
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM SCHEMA_MIGRATIONS WHERE Version = $1", m.Version); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Applies all pending migrations up to target version (everything if target is 0).
+func MigrateUp(ctx context.Context, db *sql.DB, target int64) error {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return err
+	}
+
+	return withMigrationLock(ctx, db, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, m := range migrations {
+			if target != 0 && m.Version > target {
+				break
+			}
+			if applied[m.Version] {
+				continue
+			}
+			if err := applyMigration(ctx, conn, m, true); err != nil {
+				return err
+			}
+			log.Printf("Applied migration %d_%v", m.Version, m.Name)
+		}
+		return nil
+	})
+}
+
+// Rolls back applied migrations with version greater than target.
+func MigrateDown(ctx context.Context, db *sql.DB, target int64) error {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return err
+	}
+
+	return withMigrationLock(ctx, db, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(migrations) - 1; i >= 0; i-- {
+			m := migrations[i]
+			if m.Version <= target {
+				break
+			}
+			if !applied[m.Version] {
+				continue
+			}
+			if err := applyMigration(ctx, conn, m, false); err != nil {
+				return err
+			}
+			log.Printf("Rolled back migration %d_%v", m.Version, m.Name)
+		}
+		return nil
+	})
+}
+
+func MigrationStatus(ctx context.Context, db *sql.DB) ([]TMigrationStatus, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []TMigrationStatus
+	err = withMigrationLock(ctx, db, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, m := range migrations {
+			statuses = append(statuses, TMigrationStatus{Migration: m, Applied: applied[m.Version]})
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// Handles `post_service migrate <up|down|status> [-to version]`.
+func RunMigrateCommand(args []string) int {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	to := flags.Int64("to", -1, "target schema version (up: latest by default, down: previous by default)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: post_service migrate <up|down|status> [-to version]")
+		flags.PrintDefaults()
+	}
+	if len(args) == 0 {
+		flags.Usage()
+		return 2
+	}
+	command := args[0]
+	flags.Parse(args[1:])
+
+	conn, err := ConnectDB()
+	if err != nil {
+		log.Printf("failed to connect to database: %v", err)
+		return 1
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+	switch command {
+	case "up":
+		target := *to
+		if target < 0 {
+			target = 0
+		}
+		err = MigrateUp(ctx, conn, target)
+	case "down":
+		target := *to
+		if target < 0 {
+			target, err = previousVersion(ctx, conn)
+			if err != nil {
+				break
+			}
+		}
+		err = MigrateDown(ctx, conn, target)
+	case "status":
+		var statuses []TMigrationStatus
+		statuses, err = MigrationStatus(ctx, conn)
+		for _, s := range statuses {
+			state := "pending"
+			if s.Applied {
+				state = "applied"
+			}
+			fmt.Fprintf(os.Stdout, "%04d_%v\t%v\n", s.Migration.Version, s.Migration.Name, state)
+		}
+	default:
+		flags.Usage()
+		return 2
+	}
+
+	if err != nil {
+		log.Printf("migrate %v failed: %v", command, err)
+		return 1
+	}
+	return 0
+}
+
+// Returns the version right before the latest applied one, so that `down` rolls back a single step.
+func previousVersion(ctx context.Context, db *sql.DB) (int64, error) {
+	statuses, err := MigrationStatus(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	var applied []int64
+	for _, s := range statuses {
+		if s.Applied {
+			applied = append(applied, s.Migration.Version)
+		}
+	}
+	if len(applied) < 2 {
+		return 0, nil
+	}
+	return applied[len(applied)-2], nil
+}
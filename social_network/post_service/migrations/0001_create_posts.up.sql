@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS POSTS (
+	PostId SERIAL PRIMARY KEY,
+	Title TEXT,
+	Content TEXT,
+	AuthorLogin TEXT
+);
@@ -0,0 +1,13 @@
+CREATE TABLE POST_REVISIONS (
+	RevisionId BIGSERIAL PRIMARY KEY,
+	PostId INTEGER NOT NULL REFERENCES POSTS (PostId) ON DELETE CASCADE,
+	Title TEXT,
+	Content TEXT,
+	AuthorLogin TEXT NOT NULL,
+	CreatedAt TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX POST_REVISIONS_POST_ID_IDX ON POST_REVISIONS (PostId, RevisionId);
+
+INSERT INTO POST_REVISIONS (PostId, Title, Content, AuthorLogin)
+SELECT PostId, Title, Content, AuthorLogin FROM POSTS ORDER BY PostId;
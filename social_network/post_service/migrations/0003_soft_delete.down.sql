@@ -0,0 +1,5 @@
+DELETE FROM POSTS WHERE DeletedAt IS NOT NULL;
+
+DROP INDEX IF EXISTS POSTS_DELETED_AT_IDX;
+
+ALTER TABLE POSTS DROP COLUMN DeletedAt;
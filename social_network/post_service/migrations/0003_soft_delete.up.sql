@@ -0,0 +1,3 @@
+ALTER TABLE POSTS ADD COLUMN DeletedAt TIMESTAMPTZ;
+
+CREATE INDEX POSTS_DELETED_AT_IDX ON POSTS (DeletedAt) WHERE DeletedAt IS NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE POSTS DROP COLUMN Version;
@@ -0,0 +1 @@
+ALTER TABLE POSTS ADD COLUMN Version BIGINT NOT NULL DEFAULT 1;
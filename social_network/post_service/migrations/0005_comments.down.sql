@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS COMMENT_LIKES;
+
+DROP TABLE IF EXISTS COMMENTS;
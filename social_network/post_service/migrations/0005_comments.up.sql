@@ -0,0 +1,22 @@
+CREATE TABLE COMMENTS (
+	CommentId BIGSERIAL PRIMARY KEY,
+	PostId INTEGER NOT NULL REFERENCES POSTS (PostId) ON DELETE CASCADE,
+	ParentId BIGINT NOT NULL DEFAULT 0,
+	Depth INTEGER NOT NULL DEFAULT 0,
+	AuthorLogin TEXT NOT NULL,
+	Content TEXT NOT NULL,
+	Likes BIGINT NOT NULL DEFAULT 0,
+	RepliesCount BIGINT NOT NULL DEFAULT 0,
+	CreatedAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+	UpdatedAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+	DeletedAt TIMESTAMPTZ
+);
+
+CREATE INDEX COMMENTS_THREAD_IDX ON COMMENTS (PostId, ParentId, CommentId);
+CREATE INDEX COMMENTS_THREAD_TOP_IDX ON COMMENTS (PostId, ParentId, Likes, CommentId);
+
+CREATE TABLE COMMENT_LIKES (
+	CommentId BIGINT NOT NULL REFERENCES COMMENTS (CommentId) ON DELETE CASCADE,
+	Login TEXT NOT NULL,
+	PRIMARY KEY (CommentId, Login)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS POST_TAGS;
+
+DROP TABLE IF EXISTS TAGS;
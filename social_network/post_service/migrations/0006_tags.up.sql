@@ -0,0 +1,24 @@
+CREATE TABLE TAGS (
+	TagId SERIAL PRIMARY KEY,
+	Name TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE POST_TAGS (
+	PostId INTEGER NOT NULL REFERENCES POSTS (PostId) ON DELETE CASCADE,
+	TagId INTEGER NOT NULL REFERENCES TAGS (TagId) ON DELETE CASCADE,
+	PRIMARY KEY (PostId, TagId)
+);
+
+CREATE INDEX POST_TAGS_TAG_ID_IDX ON POST_TAGS (TagId, PostId);
+
+INSERT INTO TAGS (Name)
+SELECT DISTINCT lower(m.groups[1])
+FROM POSTS AS p
+CROSS JOIN LATERAL regexp_matches(p.Content, '#([[:alnum:]_]{1,64})', 'g') AS m(groups)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO POST_TAGS (PostId, TagId)
+SELECT DISTINCT p.PostId, t.TagId
+FROM POSTS AS p
+CROSS JOIN LATERAL regexp_matches(p.Content, '#([[:alnum:]_]{1,64})', 'g') AS m(groups)
+JOIN TAGS AS t ON t.Name = lower(m.groups[1]);
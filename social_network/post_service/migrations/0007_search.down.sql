@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS POSTS_AUTHOR_CREATED_AT_IDX;
+DROP INDEX IF EXISTS POSTS_CONTENT_TSV_IDX;
+DROP INDEX IF EXISTS POSTS_TITLE_TSV_IDX;
+
+ALTER TABLE POSTS
+	DROP COLUMN ContentTsv,
+	DROP COLUMN TitleTsv,
+	DROP COLUMN CreatedAt;
@@ -0,0 +1,13 @@
+ALTER TABLE POSTS ADD COLUMN CreatedAt TIMESTAMPTZ NOT NULL DEFAULT now();
+
+UPDATE POSTS SET CreatedAt = r.FirstRevisionAt
+FROM (SELECT PostId, min(CreatedAt) AS FirstRevisionAt FROM POST_REVISIONS GROUP BY PostId) AS r
+WHERE POSTS.PostId = r.PostId;
+
+ALTER TABLE POSTS
+	ADD COLUMN TitleTsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', coalesce(Title, ''))) STORED,
+	ADD COLUMN ContentTsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', coalesce(Content, ''))) STORED;
+
+CREATE INDEX POSTS_TITLE_TSV_IDX ON POSTS USING GIN (TitleTsv);
+CREATE INDEX POSTS_CONTENT_TSV_IDX ON POSTS USING GIN (ContentTsv);
+CREATE INDEX POSTS_AUTHOR_CREATED_AT_IDX ON POSTS (AuthorLogin, CreatedAt);
@@ -0,0 +1,11 @@
+CREATE TABLE ATTACHMENTS (
+	AttachmentId BIGSERIAL PRIMARY KEY,
+	PostId INTEGER NOT NULL REFERENCES POSTS (PostId) ON DELETE CASCADE,
+	FileName TEXT NOT NULL,
+	ContentType TEXT NOT NULL,
+	Size BIGINT NOT NULL,
+	BlobKey TEXT NOT NULL UNIQUE,
+	CreatedAt TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX ATTACHMENTS_POST_ID_IDX ON ATTACHMENTS (PostId, AttachmentId);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS ATTACHMENT_VARIANTS;
+
+ALTER TABLE ATTACHMENTS
+	DROP COLUMN IF EXISTS Width,
+	DROP COLUMN IF EXISTS Height,
+	DROP COLUMN IF EXISTS BlurHash;
@@ -0,0 +1,19 @@
+ALTER TABLE ATTACHMENTS
+	ADD COLUMN Width INTEGER NOT NULL DEFAULT 0,
+	ADD COLUMN Height INTEGER NOT NULL DEFAULT 0,
+	ADD COLUMN BlurHash TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE ATTACHMENT_VARIANTS (
+	AttachmentId BIGINT NOT NULL REFERENCES ATTACHMENTS (AttachmentId) ON DELETE CASCADE,
+	Name TEXT NOT NULL,
+	State TEXT NOT NULL DEFAULT 'PENDING',
+	Width INTEGER NOT NULL DEFAULT 0,
+	Height INTEGER NOT NULL DEFAULT 0,
+	Size BIGINT NOT NULL DEFAULT 0,
+	ContentType TEXT NOT NULL DEFAULT '',
+	BlobKey TEXT NOT NULL DEFAULT '',
+	UpdatedAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (AttachmentId, Name)
+);
+
+CREATE INDEX ATTACHMENT_VARIANTS_PENDING_IDX ON ATTACHMENT_VARIANTS (AttachmentId) WHERE State = 'PENDING';
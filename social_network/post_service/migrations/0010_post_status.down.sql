@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS POSTS_SCHEDULED_IDX;
+
+ALTER TABLE POSTS
+	DROP COLUMN IF EXISTS Status,
+	DROP COLUMN IF EXISTS PublishAt;
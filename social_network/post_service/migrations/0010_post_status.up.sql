@@ -0,0 +1,7 @@
+ALTER TABLE POSTS
+	ADD COLUMN Status TEXT NOT NULL DEFAULT 'PUBLISHED',
+	ADD COLUMN PublishAt TIMESTAMPTZ;
+
+UPDATE POSTS SET PublishAt = CreatedAt;
+
+CREATE INDEX POSTS_SCHEDULED_IDX ON POSTS (PublishAt) WHERE Status = 'SCHEDULED';
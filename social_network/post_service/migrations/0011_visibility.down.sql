@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS FOLLOWS;
+
+ALTER TABLE POSTS DROP COLUMN IF EXISTS Visibility;
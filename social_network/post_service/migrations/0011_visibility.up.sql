@@ -0,0 +1,10 @@
+ALTER TABLE POSTS ADD COLUMN Visibility TEXT NOT NULL DEFAULT 'PUBLIC';
+
+CREATE TABLE FOLLOWS (
+	Follower TEXT NOT NULL,
+	Followee TEXT NOT NULL,
+	CreatedAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (Follower, Followee)
+);
+
+CREATE INDEX FOLLOWS_FOLLOWEE_IDX ON FOLLOWS (Followee);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS NOTIFICATIONS;
+
+DROP INDEX IF EXISTS POSTS_REPOST_UNIQUE_IDX;
+DROP INDEX IF EXISTS POSTS_REF_IDX;
+
+ALTER TABLE POSTS
+	DROP COLUMN IF EXISTS Kind,
+	DROP COLUMN IF EXISTS RefPostId;
@@ -0,0 +1,19 @@
+-- RefPostId has no foreign key: reposts outlive the purged post and render it as a tombstone
+ALTER TABLE POSTS
+	ADD COLUMN Kind TEXT NOT NULL DEFAULT 'ORIGINAL',
+	ADD COLUMN RefPostId INTEGER;
+
+CREATE INDEX POSTS_REF_IDX ON POSTS (RefPostId) WHERE RefPostId IS NOT NULL;
+CREATE UNIQUE INDEX POSTS_REPOST_UNIQUE_IDX ON POSTS (AuthorLogin, RefPostId) WHERE Kind = 'REPOST' AND DeletedAt IS NULL;
+
+CREATE TABLE NOTIFICATIONS (
+	NotificationId BIGSERIAL PRIMARY KEY,
+	RecipientLogin TEXT NOT NULL,
+	Type TEXT NOT NULL,
+	ActorLogin TEXT NOT NULL,
+	PostId INTEGER NOT NULL REFERENCES POSTS (PostId) ON DELETE CASCADE,
+	RefPostId INTEGER REFERENCES POSTS (PostId) ON DELETE CASCADE,
+	CreatedAt TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX NOTIFICATIONS_RECIPIENT_IDX ON NOTIFICATIONS (RecipientLogin, NotificationId);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS POLL_OPTIONS;
+DROP TABLE IF EXISTS POLLS;
@@ -0,0 +1,11 @@
+CREATE TABLE POLLS (
+	PostId INTEGER PRIMARY KEY REFERENCES POSTS (PostId) ON DELETE CASCADE,
+	ClosesAt TIMESTAMPTZ
+);
+
+CREATE TABLE POLL_OPTIONS (
+	PostId INTEGER NOT NULL REFERENCES POLLS (PostId) ON DELETE CASCADE,
+	OptionId INTEGER NOT NULL,
+	Text TEXT NOT NULL,
+	PRIMARY KEY (PostId, OptionId)
+);
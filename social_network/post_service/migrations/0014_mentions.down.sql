@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS POSTS_MENTIONS_IDX;
+
+ALTER TABLE POST_REVISIONS DROP COLUMN IF EXISTS Mentions;
+ALTER TABLE POSTS DROP COLUMN IF EXISTS Mentions;
@@ -0,0 +1,5 @@
+-- Mentions are resolved against registered users by main_service, revisions keep them so that restores bring them back
+ALTER TABLE POSTS ADD COLUMN Mentions TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE POST_REVISIONS ADD COLUMN Mentions TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX POSTS_MENTIONS_IDX ON POSTS USING GIN (Mentions);
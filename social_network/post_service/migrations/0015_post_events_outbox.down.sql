@@ -0,0 +1 @@
+DROP TABLE IF EXISTS POST_EVENTS_OUTBOX;
@@ -0,0 +1,6 @@
+-- Post events are stored in the transaction that caused them and relayed to Kafka by the scheduler loop
+CREATE TABLE POST_EVENTS_OUTBOX (
+	EventId BIGSERIAL PRIMARY KEY,
+	Event BYTEA NOT NULL,
+	CreatedAt TIMESTAMPTZ NOT NULL DEFAULT now()
+);
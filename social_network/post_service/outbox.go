@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	pb "proto"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/proto"
+)
+
+// Events relayed by one run at most, the rest go out right after
+const relayBatchSize = 100
+
+// Arbitrary key shared by all post_service replicas, so only one of them relays at a time and the events keep their order.
+const relayLockKey int64 = 0x6f7574626f78
+
+// Buffered, so a commit never waits for the relay and several commits wake it once.
+var relayWakeup = make(chan struct{}, 1)
+
+// Stores the event in the transaction that caused it. It reaches PostEventsTopic only if the transaction commits,
+// and then even if post_service stops right after the commit.
+func enqueuePostEvent(ctx context.Context, tx *sql.Tx, event *pb.TPostEvent) error {
+	serializedEvent, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO POST_EVENTS_OUTBOX (Event) VALUES ($1)", serializedEvent)
+	return err
+}
+
+// Asks the scheduler loop to relay committed events now instead of on the next tick.
+func wakeRelay() {
+	select {
+	case relayWakeup <- struct{}{}:
+	default:
+	}
+}
+
+// Sends stored events in the order they were stored and deletes them in the same transaction. A failed send
+// leaves the batch for the next run, so an event may be sent twice, but never lost.
+func RelayPostEvents(ctx context.Context) (int, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err := tx.QueryRowContext(ctx, "SELECT pg_try_advisory_xact_lock($1)", relayLockKey).Scan(&locked); err != nil {
+		return 0, err
+	}
+	if !locked {
+		return 0, nil
+	}
+
+	rows, err := tx.QueryContext(ctx, "SELECT EventId, Event FROM POST_EVENTS_OUTBOX ORDER BY EventId LIMIT $1", relayBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var eventIds []int64
+	var events []*pb.TPostEvent
+	for rows.Next() {
+		var eventId int64
+		var serializedEvent []byte
+		if err := rows.Scan(&eventId, &serializedEvent); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		event := &pb.TPostEvent{}
+		if err := proto.Unmarshal(serializedEvent, event); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		eventIds = append(eventIds, eventId)
+		events = append(events, event)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	for _, event := range events {
+		if err := PublishPostEvent(event); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM POST_EVENTS_OUTBOX WHERE EventId = ANY($1)", pq.Array(eventIds)); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
+
+func relayPendingEvents() {
+	for {
+		relayed, err := RelayPostEvents(context.Background())
+		if err != nil {
+			log.Printf("failed to relay post events: %v", err)
+			return
+		}
+		if relayed < relayBatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	pb "proto"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	MinPollOptions = 2
+	MaxPollOptions = 10
+)
+
+func validatePoll(request *pb.TCreatePostRequest) error {
+	poll := request.Poll
+	if poll == nil {
+		return nil
+	}
+	if request.Kind == pb.TPost_REPOST {
+		return status.Errorf(codes.InvalidArgument, "reposts can not carry a poll")
+	}
+	if len(poll.Options) < MinPollOptions || len(poll.Options) > MaxPollOptions {
+		return status.Errorf(codes.InvalidArgument, "poll should have from %d to %d options", MinPollOptions, MaxPollOptions)
+	}
+	for _, option := range poll.Options {
+		if strings.TrimSpace(option.Text) == "" {
+			return status.Errorf(codes.InvalidArgument, "poll options must not be empty")
+		}
+	}
+	if poll.ClosesAt != nil && !poll.ClosesAt.AsTime().After(time.Now()) {
+		return status.Errorf(codes.InvalidArgument, "poll should close in the future")
+	}
+	return nil
+}
+
+// Stores the poll of a new post, options are numbered from 1 in the given order.
+func insertPoll(ctx context.Context, tx *sql.Tx, postId uint64, poll *pb.TPoll) error {
+	var closesAt sql.NullTime
+	if poll.ClosesAt != nil {
+		closesAt = sql.NullTime{Time: poll.ClosesAt.AsTime(), Valid: true}
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO POLLS (PostId, ClosesAt) VALUES ($1, $2)", postId, closesAt); err != nil {
+		return err
+	}
+	for i, option := range poll.Options {
+		_, err := tx.ExecContext(ctx, "INSERT INTO POLL_OPTIONS (PostId, OptionId, Text) VALUES ($1, $2, $3)", postId, i+1, strings.TrimSpace(option.Text))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Fills in polls of the posts. Votes are counted by stats_service, so only the options are loaded.
+func loadPolls(ctx context.Context, posts []*pb.TPost) error {
+	if len(posts) == 0 {
+		return nil
+	}
+	byId := make(map[uint64]*pb.TPost, len(posts))
+	ids := make([]int64, 0, len(posts))
+	for _, post := range posts {
+		byId[post.PostId] = post
+		ids = append(ids, int64(post.PostId))
+	}
+
+	rows, err := db.QueryContext(
+		ctx,
+		`SELECT p.PostId, p.ClosesAt, o.OptionId, o.Text
+		FROM POLLS AS p JOIN POLL_OPTIONS AS o ON o.PostId = p.PostId
+		WHERE p.PostId = ANY($1)
+		ORDER BY p.PostId, o.OptionId`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postId uint64
+		var closesAt sql.NullTime
+		option := &pb.TPoll_TOption{}
+		if err := rows.Scan(&postId, &closesAt, &option.OptionId, &option.Text); err != nil {
+			return err
+		}
+		post, ok := byId[postId]
+		if !ok {
+			continue
+		}
+		if post.Poll == nil {
+			post.Poll = &pb.TPoll{}
+			if closesAt.Valid {
+				post.Poll.ClosesAt = timestamppb.New(closesAt.Time)
+			}
+		}
+		post.Poll.Options = append(post.Poll.Options, option)
+	}
+	return rows.Err()
+}
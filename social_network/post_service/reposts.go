@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	pb "proto"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Validates the reference of a repost or quote and resolves it to the post that is actually shared:
+// reposting a repost shares the original post.
+func resolveReference(ctx context.Context, tx *sql.Tx, request *pb.TCreatePostRequest) (refPostId uint64, refAuthor string, err error) {
+	if request.Kind == pb.TPost_ORIGINAL {
+		if request.RefPostId != 0 {
+			return 0, "", status.Errorf(codes.InvalidArgument, "only reposts and quotes can reference a post")
+		}
+		return 0, "", nil
+	}
+	if request.RefPostId == 0 {
+		return 0, "", status.Errorf(codes.InvalidArgument, "%v requires a referenced post", request.Kind)
+	}
+	if request.Status != pb.TPost_PUBLISHED {
+		return 0, "", status.Errorf(codes.InvalidArgument, "%v can not be a draft", request.Kind)
+	}
+	switch request.Kind {
+	case pb.TPost_REPOST:
+		if request.Title != "" || request.Content != "" {
+			return 0, "", status.Errorf(codes.InvalidArgument, "reposts have no title or content, quote the post instead")
+		}
+	case pb.TPost_QUOTE:
+		if strings.TrimSpace(request.Content) == "" {
+			return 0, "", status.Errorf(codes.InvalidArgument, "quotes require content")
+		}
+	}
+
+	refPostId = request.RefPostId
+	for {
+		var kind string
+		var nextRef sql.NullInt64
+		// only public posts can be shared, so that a repost never reveals what its readers can not see
+		err = tx.QueryRowContext(
+			ctx,
+			"SELECT AuthorLogin, Kind, RefPostId FROM POSTS WHERE PostId = $1 AND DeletedAt IS NULL AND Status = 'PUBLISHED' AND Visibility = 'PUBLIC'",
+			refPostId,
+		).Scan(&refAuthor, &kind, &nextRef)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, "", status.Errorf(codes.NotFound, "referenced post not found")
+		}
+		if err != nil {
+			return 0, "", status.Errorf(codes.Internal, "failed to fetch referenced post: %v", err)
+		}
+		if kind != pb.TPost_REPOST.String() {
+			return refPostId, refAuthor, nil
+		}
+		refPostId = uint64(nextRef.Int64)
+	}
+}
+
+// Notifies the author of the shared post about a new repost or quote and lets stats_service count the share
+// once the transaction commits.
+func recordReference(ctx context.Context, tx *sql.Tx, postId uint64, request *pb.TCreatePostRequest, refPostId uint64, refAuthor string) error {
+	notificationType, eventType := pb.TNotification_REPOSTED, pb.TPostEvent_REPOSTED
+	if request.Kind == pb.TPost_QUOTE {
+		notificationType, eventType = pb.TNotification_QUOTED, pb.TPostEvent_QUOTED
+	}
+	if err := insertNotification(ctx, tx, refAuthor, notificationType, request.AuthorLogin, postId, refPostId); err != nil {
+		return err
+	}
+	return enqueuePostEvent(ctx, tx, &pb.TPostEvent{
+		Type:        eventType,
+		PostId:      postId,
+		AuthorLogin: request.AuthorLogin,
+		RefPostId:   refPostId,
+	})
+}
+
+// Tells apart a second repost of the same post from other insert failures.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// Fills in the posts shared by reposts and quotes. The shared post is rendered while it is published and public
+// or unlisted, otherwise the reference is a tombstone. References are not followed any deeper.
+func loadReferences(ctx context.Context, posts []*pb.TPost) error {
+	var ids []int64
+	for _, post := range posts {
+		if post.Reference != nil {
+			ids = append(ids, int64(post.Reference.PostId))
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	rows, err := db.QueryContext(
+		ctx,
+		"SELECT "+postColumns+` FROM POSTS AS p
+		WHERE p.PostId = ANY($1) AND p.DeletedAt IS NULL AND p.Status = 'PUBLISHED' AND p.Visibility IN ('PUBLIC', 'UNLISTED')`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	byId := make(map[uint64]*pb.TPost, len(ids))
+	var refs []*pb.TPost
+	for rows.Next() {
+		ref, err := scanPost(rows)
+		if err != nil {
+			return err
+		}
+		// the referenced post itself is shown without its own reference
+		ref.Reference = nil
+		byId[ref.PostId] = ref
+		refs = append(refs, ref)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if err := loadTags(ctx, refs); err != nil {
+		return err
+	}
+	if err := loadAttachments(ctx, refs); err != nil {
+		return err
+	}
+	if err := loadPolls(ctx, refs); err != nil {
+		return err
+	}
+
+	for _, post := range posts {
+		if post.Reference == nil {
+			continue
+		}
+		post.Reference.Post = byId[post.Reference.PostId]
+		post.Reference.Deleted = post.Reference.Post == nil
+	}
+	return nil
+}
+
+// Notifications are stored next to the posts they are about and go away when those are purged.
+// Users are not notified about their own actions.
+func insertNotification(ctx context.Context, tx *sql.Tx, recipient string, notificationType pb.TNotification_EType, actor string, postId uint64, refPostId uint64) error {
+	if recipient == actor {
+		return nil
+	}
+	_, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO NOTIFICATIONS (RecipientLogin, Type, ActorLogin, PostId, RefPostId) VALUES ($1, $2, $3, $4, $5)",
+		recipient,
+		notificationType.String(),
+		actor,
+		postId,
+		sql.NullInt64{Int64: int64(refPostId), Valid: refPostId != 0},
+	)
+	return err
+}
+
+// Lists the user's notifications, the newest go first.
+func (s *server) ListNotifications(ctx context.Context, req *pb.TListNotificationsRequest) (*pb.TListNotificationsResponse, error) {
+	rows, err := db.QueryContext(
+		ctx,
+		`SELECT NotificationId, Type, ActorLogin, PostId, COALESCE(RefPostId, 0), CreatedAt FROM NOTIFICATIONS
+		WHERE RecipientLogin = $1
+		ORDER BY NotificationId DESC
+		LIMIT $2 OFFSET $3`,
+		req.Login,
+		PageSize,
+		req.PageId*PageSize,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch notifications: %v", err)
+	}
+	defer rows.Close()
+
+	response := &pb.TListNotificationsResponse{}
+	for rows.Next() {
+		var notification pb.TNotification
+		var notificationType string
+		var createdAt time.Time
+		err := rows.Scan(&notification.NotificationId, &notificationType, &notification.ActorLogin, &notification.PostId, &notification.RefPostId, &createdAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan notification: %v", err)
+		}
+		notification.Type = pb.TNotification_EType(pb.TNotification_EType_value[notificationType])
+		notification.CreatedAt = timestamppb.New(createdAt)
+		response.Notifications = append(response.Notifications, &notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating over rows: %v", err)
+	}
+	return response, nil
+}
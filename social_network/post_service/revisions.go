@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	pb "proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Saves the current state of the post as a new immutable revision.
+func insertRevision(ctx context.Context, tx *sql.Tx, postId uint64, authorLogin string) (*pb.TPostRevision, error) {
+	var revision pb.TPostRevision
+	var createdAt time.Time
+	err := tx.QueryRowContext(
+		ctx,
+		`INSERT INTO POST_REVISIONS (PostId, Title, Content, Mentions, AuthorLogin)
+		SELECT PostId, Title, Content, Mentions, $2 FROM POSTS WHERE PostId = $1
+		RETURNING RevisionId, PostId, Title, Content, AuthorLogin, CreatedAt`,
+		postId,
+		authorLogin,
+	).Scan(&revision.RevisionId, &revision.PostId, &revision.Title, &revision.Content, &revision.AuthorLogin, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	revision.CreatedAt = timestamppb.New(createdAt)
+	return &revision, nil
+}
+
+func getRevision(ctx context.Context, postId uint64, revisionId uint64) (*pb.TPostRevision, error) {
+	var revision pb.TPostRevision
+	var createdAt time.Time
+	err := db.QueryRowContext(
+		ctx,
+		"SELECT RevisionId, PostId, Title, Content, AuthorLogin, CreatedAt FROM POST_REVISIONS WHERE PostId = $1 AND RevisionId = $2",
+		postId,
+		revisionId,
+	).Scan(&revision.RevisionId, &revision.PostId, &revision.Title, &revision.Content, &revision.AuthorLogin, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "revision %d of post %d not found", revisionId, postId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch revision: %v", err)
+	}
+	revision.CreatedAt = timestamppb.New(createdAt)
+	return &revision, nil
+}
+
+func (s *server) ListPostRevisions(ctx context.Context, req *pb.TListPostRevisionsRequest) (*pb.TListPostRevisionsResponse, error) {
+	if err := checkPostVisible(ctx, req.PostId, req.ViewerLogin); err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(
+		ctx,
+		"SELECT RevisionId, PostId, Title, Content, AuthorLogin, CreatedAt FROM POST_REVISIONS WHERE PostId = $1 ORDER BY RevisionId",
+		req.PostId,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch revisions: %v", err)
+	}
+	defer rows.Close()
+
+	var revisions []*pb.TPostRevision
+	for rows.Next() {
+		var revision pb.TPostRevision
+		var createdAt time.Time
+		if err := rows.Scan(&revision.RevisionId, &revision.PostId, &revision.Title, &revision.Content, &revision.AuthorLogin, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan revision: %v", err)
+		}
+		revision.CreatedAt = timestamppb.New(createdAt)
+		revisions = append(revisions, &revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating over rows: %v", err)
+	}
+	if len(revisions) == 0 {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+
+	return &pb.TListPostRevisionsResponse{Revisions: revisions}, nil
+}
+
+func (s *server) GetPostRevision(ctx context.Context, req *pb.TGetPostRevisionRequest) (*pb.TGetPostRevisionResponse, error) {
+	if err := checkPostVisible(ctx, req.PostId, req.ViewerLogin); err != nil {
+		return nil, err
+	}
+	revision, err := getRevision(ctx, req.PostId, req.RevisionId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TGetPostRevisionResponse{Revision: revision}, nil
+}
+
+func (s *server) DiffPostRevisions(ctx context.Context, req *pb.TDiffPostRevisionsRequest) (*pb.TDiffPostRevisionsResponse, error) {
+	if err := checkPostVisible(ctx, req.PostId, req.ViewerLogin); err != nil {
+		return nil, err
+	}
+	from, err := getRevision(ctx, req.PostId, req.FromRevisionId)
+	if err != nil {
+		return nil, err
+	}
+	to, err := getRevision(ctx, req.PostId, req.ToRevisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.TDiffPostRevisionsResponse{}
+	if response.Title, err = DiffLines(from.Title, to.Title); err != nil {
+		return nil, err
+	}
+	if response.Content, err = DiffLines(from.Content, to.Content); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Restoring never rewrites history: the old revision is copied into the post and saved as the newest revision.
+func (s *server) RestorePostRevision(ctx context.Context, req *pb.TRestorePostRevisionRequest) (*pb.TGetPostRevisionResponse, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore revision: %v", err)
+	}
+	defer tx.Rollback()
+
+	var authorLogin string
+	err = tx.QueryRowContext(ctx, "SELECT AuthorLogin FROM POSTS WHERE PostId = $1 AND DeletedAt IS NULL FOR UPDATE", req.PostId).Scan(&authorLogin)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore revision: %v", err)
+	}
+	if authorLogin != req.AuthorLogin {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not the author of this post")
+	}
+
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE POSTS SET Title = r.Title, Content = r.Content, Mentions = r.Mentions, Version = POSTS.Version + 1
+		FROM POST_REVISIONS AS r
+		WHERE POSTS.PostId = $1 AND r.PostId = $1 AND r.RevisionId = $2`,
+		req.PostId,
+		req.RevisionId,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore revision: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore revision: %v", err)
+	}
+	if rowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "revision %d of post %d not found", req.RevisionId, req.PostId)
+	}
+
+	revision, err := insertRevision(ctx, tx, req.PostId, req.AuthorLogin)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save post revision: %v", err)
+	}
+	if err := syncPostTags(ctx, tx, req.PostId, req.AuthorLogin); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save post tags: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore revision: %v", err)
+	}
+	wakeRelay()
+	return &pb.TGetPostRevisionResponse{Revision: revision}, nil
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+
+	pb "proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ts_headline marks matches with these private use characters, they become <b></b> once the text around them is
+// HTML-escaped. They are removed from the post before it is highlighted, so a post can not forge them.
+const (
+	highlightStart = "\uE000"
+	highlightStop  = "\uE001"
+)
+
+const searchHeadlineOptions = "StartSel=" + highlightStart + ", StopSel=" + highlightStop + ", MaxFragments=3, MaxWords=20, MinWords=5"
+
+var highlightMarkers = strings.NewReplacer(highlightStart, "<b>", highlightStop, "</b>")
+
+// Highlights are HTML, the post text in them is escaped and only the matches are markup.
+func renderHighlight(headline string) string {
+	return highlightMarkers.Replace(html.EscapeString(headline))
+}
+
+// Translates the user's query into to_tsquery syntax, see TSearchPostsRequest for the supported operators.
+// Only letters and digits get into the result, so the query can not break tsquery syntax.
+func BuildTsQuery(query string) (string, error) {
+	var terms []string
+	positive := 0
+
+	addTerm := func(token string, phrase bool) {
+		negated := !phrase && strings.HasPrefix(token, "-")
+		token = strings.TrimPrefix(token, "-")
+		prefix := !phrase && strings.HasSuffix(token, "*")
+
+		words := strings.FieldsFunc(strings.ToLower(token), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if len(words) == 0 {
+			return
+		}
+		if prefix {
+			words[len(words)-1] += ":*"
+		}
+
+		term := strings.Join(words, " <-> ")
+		if negated {
+			term = "!(" + term + ")"
+		} else {
+			positive++
+		}
+		if len(words) > 1 {
+			term = "(" + term + ")"
+		}
+		terms = append(terms, term)
+	}
+
+	for query != "" {
+		query = strings.TrimLeftFunc(query, unicode.IsSpace)
+		if strings.HasPrefix(query, `"`) {
+			phrase, rest, _ := strings.Cut(query[1:], `"`)
+			addTerm(phrase, true)
+			query = rest
+			continue
+		}
+		end := strings.IndexFunc(query, unicode.IsSpace)
+		if end < 0 {
+			end = len(query)
+		}
+		addTerm(query[:end], false)
+		query = query[end:]
+	}
+
+	if positive == 0 {
+		return "", fmt.Errorf("query should contain at least one word to search for")
+	}
+	return strings.Join(terms, " & "), nil
+}
+
+func (s *server) SearchPosts(ctx context.Context, req *pb.TSearchPostsRequest) (*pb.TSearchPostsResponse, error) {
+	tsQuery, err := BuildTsQuery(req.Query)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+
+	args := []any{tsQuery, req.ViewerLogin}
+	filters := []string{listedForCondition("$2")}
+	if req.AuthorLogin != "" {
+		args = append(args, req.AuthorLogin)
+		filters = append(filters, fmt.Sprintf("p.AuthorLogin = $%d", len(args)))
+	}
+	if req.CreatedFrom != nil {
+		args = append(args, req.CreatedFrom.AsTime())
+		filters = append(filters, fmt.Sprintf("p.CreatedAt >= $%d", len(args)))
+	}
+	if req.CreatedTo != nil {
+		args = append(args, req.CreatedTo.AsTime())
+		filters = append(filters, fmt.Sprintf("p.CreatedAt < $%d", len(args)))
+	}
+	args = append(args, PageSize, req.PageId*PageSize)
+
+	filter := "AND " + strings.Join(filters, " AND ")
+
+	rows, err := db.QueryContext(
+		ctx,
+		fmt.Sprintf(`
+		SELECT %[1]s,
+			ts_rank(setweight(p.TitleTsv, 'A') || setweight(p.ContentTsv, 'B'), q.Query) AS Rank,
+			ts_headline('simple', translate(coalesce(p.Title, ''), '%[2]s', ''), q.Query, '%[3]s'),
+			ts_headline('simple', translate(coalesce(p.Content, ''), '%[2]s', ''), q.Query, '%[3]s')
+		FROM POSTS AS p, to_tsquery('simple', $1) AS q(Query)
+		WHERE (p.TitleTsv @@ q.Query OR p.ContentTsv @@ q.Query) %[4]s
+		ORDER BY Rank DESC, p.PostId DESC
+		LIMIT $%[5]d OFFSET $%[6]d`,
+			postColumns,
+			highlightStart+highlightStop,
+			searchHeadlineOptions,
+			filter,
+			len(args)-1,
+			len(args),
+		),
+		args...,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search posts: %v", err)
+	}
+	defer rows.Close()
+
+	response := &pb.TSearchPostsResponse{}
+	var posts []*pb.TPost
+	for rows.Next() {
+		hit := &pb.TSearchPostsResponse_THit{}
+		hit.Post, err = scanPost(rows, &hit.Rank, &hit.TitleHighlight, &hit.ContentHighlight)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan post: %v", err)
+		}
+		hit.TitleHighlight = renderHighlight(hit.TitleHighlight)
+		hit.ContentHighlight = renderHighlight(hit.ContentHighlight)
+		response.Hits = append(response.Hits, hit)
+		posts = append(posts, hit.Post)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating over rows: %v", err)
+	}
+	if err := loadTags(ctx, posts); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch tags: %v", err)
+	}
+	if err := loadAttachments(ctx, posts); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch attachments: %v", err)
+	}
+	if err := loadPolls(ctx, posts); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch polls: %v", err)
+	}
+	if err := loadReferences(ctx, posts); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch shared posts: %v", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+
+	pb "proto"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Keep in sync with the backfill in migrations/0006_tags.up.sql
+var hashtagRegexp = regexp.MustCompile(`#([\p{L}\p{N}_]{1,64})`)
+
+// Returns lowercased hashtags of the content in order of their first appearance.
+func ExtractHashtags(content string) []string {
+	var tags []string
+	seen := map[string]bool{}
+	for _, match := range hashtagRegexp.FindAllStringSubmatch(content, -1) {
+		tag := strings.ToLower(match[1])
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// Replaces tags of the post with the ones found in its current content. The TAGS_CHANGED event lets stats_service
+// attribute views and likes to tags once the transaction commits.
+func syncPostTags(ctx context.Context, tx *sql.Tx, postId uint64, authorLogin string) error {
+	var content string
+	if err := tx.QueryRowContext(ctx, "SELECT Content FROM POSTS WHERE PostId = $1", postId).Scan(&content); err != nil {
+		return err
+	}
+	tags := ExtractHashtags(content)
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM POST_TAGS WHERE PostId = $1", postId); err != nil {
+		return err
+	}
+	if len(tags) > 0 {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO TAGS (Name) SELECT unnest($1::TEXT[]) ON CONFLICT DO NOTHING", pq.Array(tags)); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO POST_TAGS (PostId, TagId) SELECT $1, TagId FROM TAGS WHERE Name = ANY($2)",
+			postId,
+			pq.Array(tags),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return enqueuePostEvent(ctx, tx, &pb.TPostEvent{
+		Type:        pb.TPostEvent_TAGS_CHANGED,
+		PostId:      postId,
+		AuthorLogin: authorLogin,
+		Tags:        tags,
+	})
+}
+
+func loadTags(ctx context.Context, posts []*pb.TPost) error {
+	if len(posts) == 0 {
+		return nil
+	}
+	byId := make(map[uint64]*pb.TPost, len(posts))
+	ids := make([]int64, 0, len(posts))
+	for _, post := range posts {
+		byId[post.PostId] = post
+		ids = append(ids, int64(post.PostId))
+	}
+
+	rows, err := db.QueryContext(
+		ctx,
+		"SELECT pt.PostId, t.Name FROM POST_TAGS AS pt JOIN TAGS AS t ON t.TagId = pt.TagId WHERE pt.PostId = ANY($1) ORDER BY t.Name",
+		pq.Array(ids),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postId uint64
+		var tag string
+		if err := rows.Scan(&postId, &tag); err != nil {
+			return err
+		}
+		if post, ok := byId[postId]; ok {
+			post.Tags = append(post.Tags, tag)
+		}
+	}
+	return rows.Err()
+}
+
+func (s *server) GetPostsByTag(ctx context.Context, req *pb.TGetPostsByTagRequest) (*pb.TGetPostsOnPageResponse, error) {
+	tag := strings.ToLower(strings.TrimPrefix(req.Tag, "#"))
+	if tag == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "tag must not be empty")
+	}
+
+	posts, err := queryPosts(
+		ctx,
+		"SELECT "+postColumns+`
+		FROM POSTS AS p
+		JOIN POST_TAGS AS pt ON pt.PostId = p.PostId
+		JOIN TAGS AS t ON t.TagId = pt.TagId
+		WHERE t.Name = $1 AND `+listedForCondition("$4")+`
+		ORDER BY p.PostId DESC
+		LIMIT $2 OFFSET $3`,
+		tag,
+		PageSize,
+		req.PageId*PageSize,
+		req.ViewerLogin,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TGetPostsOnPageResponse{Posts: posts}, nil
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	pb "proto"
+
+	"github.com/IBM/sarama"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const PostEventsTopic = "PostEventsTopic"
+
+var (
+	trashRetention time.Duration
+	kafkaProducer  sarama.SyncProducer
+)
+
+func (s *server) ListTrash(ctx context.Context, req *pb.TListTrashRequest) (*pb.TListTrashResponse, error) {
+	rows, err := db.QueryContext(
+		ctx,
+		"SELECT "+postColumns+", p.DeletedAt FROM POSTS AS p WHERE p.AuthorLogin = $1 AND p.DeletedAt IS NOT NULL ORDER BY p.DeletedAt DESC",
+		req.AuthorLogin,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch trash: %v", err)
+	}
+	defer rows.Close()
+
+	var posts []*pb.TTrashedPost
+	for rows.Next() {
+		var deletedAt time.Time
+		post, err := scanPost(rows, &deletedAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan post: %v", err)
+		}
+		posts = append(posts, &pb.TTrashedPost{
+			Post:      post,
+			DeletedAt: timestamppb.New(deletedAt),
+			PurgeAt:   timestamppb.New(deletedAt.Add(trashRetention)),
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating over rows: %v", err)
+	}
+
+	return &pb.TListTrashResponse{Posts: posts}, nil
+}
+
+func (s *server) RestorePost(ctx context.Context, req *pb.TRestorePostRequest) (*emptypb.Empty, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to restore post: %v", err)
+	}
+	defer tx.Rollback()
+
+	var postStatus, visibility string
+	err = tx.QueryRowContext(
+		ctx,
+		"UPDATE POSTS SET DeletedAt = NULL WHERE PostId = $1 AND AuthorLogin = $2 AND DeletedAt IS NOT NULL RETURNING Status, Visibility",
+		req.PostId,
+		req.AuthorLogin,
+	).Scan(&postStatus, &visibility)
+	if isUniqueViolation(err) {
+		return &emptypb.Empty{}, status.Errorf(codes.AlreadyExists, "the post is reposted again since this repost was deleted")
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		var authorLogin string
+		err := tx.QueryRowContext(ctx, "SELECT AuthorLogin FROM POSTS WHERE PostId = $1 AND DeletedAt IS NOT NULL", req.PostId).Scan(&authorLogin)
+		if err == nil && authorLogin != req.AuthorLogin {
+			return &emptypb.Empty{}, status.Errorf(codes.PermissionDenied, "you are not the author of this post")
+		}
+		return &emptypb.Empty{}, status.Errorf(codes.NotFound, "post not found in trash")
+	}
+	if err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to restore post: %v", err)
+	}
+	err = enqueueTrashVisibility(ctx, tx, req.PostId, req.AuthorLogin, postStatus, pb.TPost_EVisibility(pb.TPost_EVisibility_value[visibility]))
+	if err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to restore post: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to restore post: %v", err)
+	}
+	wakeRelay()
+
+	return &emptypb.Empty{}, nil
+}
+
+// Trashed posts leave the rankings of stats_service like private ones and come back with their own visibility
+// once restored. Drafts and scheduled posts are not known to stats_service, their PUBLISHED event comes later.
+func enqueueTrashVisibility(ctx context.Context, tx *sql.Tx, postId uint64, authorLogin string, postStatus string, visibility pb.TPost_EVisibility) error {
+	if postStatus != pb.TPost_PUBLISHED.String() {
+		return nil
+	}
+	return enqueueVisibilityChanged(ctx, tx, postId, authorLogin, visibility)
+}
+
+// Events are keyed by post id, so that the events of a post are consumed in order.
+func PublishPostEvent(event *pb.TPostEvent) error {
+	serializedEvent, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, _, err = kafkaProducer.SendMessage(&sarama.ProducerMessage{
+		Topic: PostEventsTopic,
+		Key:   sarama.StringEncoder(strconv.FormatUint(event.PostId, 10)),
+		Value: sarama.ByteEncoder(serializedEvent),
+	})
+	return err
+}
+
+// Permanently removes posts whose retention period is over.
+// DELETED events are stored in the transaction that deletes the rows and relayed after the events stored before them,
+// and concurrent replicas never purge the same post twice. Attachment blobs are removed after commit.
+func PurgeExpiredPosts(ctx context.Context) (int, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// the outer select still sees attachments of purged posts and their image variants, the rows are removed by cascade at the end of the statement
+	rows, err := tx.QueryContext(
+		ctx,
+		`WITH purged AS (
+			DELETE FROM POSTS WHERE DeletedAt < now() - make_interval(secs => $1) RETURNING PostId, AuthorLogin
+		)
+		SELECT purged.PostId, purged.AuthorLogin, ARRAY(
+			SELECT a.BlobKey FROM ATTACHMENTS AS a WHERE a.PostId = purged.PostId
+			UNION ALL
+			SELECT v.BlobKey FROM ATTACHMENT_VARIANTS AS v JOIN ATTACHMENTS AS a ON a.AttachmentId = v.AttachmentId
+			WHERE a.PostId = purged.PostId AND v.BlobKey <> ''
+		)
+		FROM purged`,
+		trashRetention.Seconds(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var events []*pb.TPostEvent
+	var blobKeys []string
+	for rows.Next() {
+		event := &pb.TPostEvent{Type: pb.TPostEvent_DELETED}
+		var keys []string
+		if err := rows.Scan(&event.PostId, &event.AuthorLogin, pq.Array(&keys)); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		events = append(events, event)
+		blobKeys = append(blobKeys, keys...)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		if err := enqueuePostEvent(ctx, tx, event); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	wakeRelay()
+	deleteBlobs(ctx, blobKeys)
+	return len(events), nil
+}
+
+func RunPurgeLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		purged, err := PurgeExpiredPosts(context.Background())
+		if err != nil {
+			log.Printf("failed to purge expired posts: %v", err)
+			continue
+		}
+		if purged > 0 {
+			log.Printf("Purged %d expired posts", purged)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	pb "proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Matches posts the viewer may open by id, viewer is an SQL expression for the viewer's login, usually a parameter.
+// POSTS must be aliased as p. Hidden posts are reported as missing, so that their existence does not leak.
+func visibleToCondition(viewer string) string {
+	return fmt.Sprintf(`p.DeletedAt IS NULL AND (p.AuthorLogin = %[1]s OR (p.Status = 'PUBLISHED' AND (
+		p.Visibility IN ('PUBLIC', 'UNLISTED')
+		OR (p.Visibility = 'FOLLOWERS' AND EXISTS (SELECT 1 FROM FOLLOWS AS f WHERE f.Follower = %[1]s AND f.Followee = p.AuthorLogin))
+	)))`, viewer)
+}
+
+// Matches posts shown to the viewer in feeds, search and other listings: unlike visibleToCondition,
+// unlisted posts and unpublished ones are left out.
+func listedForCondition(viewer string) string {
+	return fmt.Sprintf(`p.DeletedAt IS NULL AND p.Status = 'PUBLISHED' AND (
+		p.Visibility = 'PUBLIC'
+		OR p.AuthorLogin = %[1]s
+		OR (p.Visibility = 'FOLLOWERS' AND EXISTS (SELECT 1 FROM FOLLOWS AS f WHERE f.Follower = %[1]s AND f.Followee = p.AuthorLogin))
+	)`, viewer)
+}
+
+// Returns NotFound unless the viewer may see the post.
+func checkPostVisible(ctx context.Context, postId uint64, viewerLogin string) error {
+	var visible bool
+	err := db.QueryRowContext(
+		ctx,
+		"SELECT EXISTS (SELECT 1 FROM POSTS AS p WHERE p.PostId = $1 AND "+visibleToCondition("$2")+")",
+		postId,
+		viewerLogin,
+	).Scan(&visible)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to fetch post: %v", err)
+	}
+	if !visible {
+		return status.Errorf(codes.NotFound, "post not found")
+	}
+	return nil
+}
+
+// Lets stats_service keep its rankings in line with the post's visibility, once the transaction commits.
+func enqueueVisibilityChanged(ctx context.Context, tx *sql.Tx, postId uint64, authorLogin string, visibility pb.TPost_EVisibility) error {
+	return enqueuePostEvent(ctx, tx, &pb.TPostEvent{
+		Type:        pb.TPostEvent_VISIBILITY_CHANGED,
+		PostId:      postId,
+		AuthorLogin: authorLogin,
+		Visibility:  visibility,
+	})
+}
+
+func (s *server) Follow(ctx context.Context, req *pb.TFollowRequest) (*emptypb.Empty, error) {
+	if req.Follower == req.Followee {
+		return &emptypb.Empty{}, status.Errorf(codes.InvalidArgument, "you can not follow yourself")
+	}
+	_, err := db.ExecContext(ctx, "INSERT INTO FOLLOWS (Follower, Followee) VALUES ($1, $2) ON CONFLICT DO NOTHING", req.Follower, req.Followee)
+	if err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to follow: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) Unfollow(ctx context.Context, req *pb.TFollowRequest) (*emptypb.Empty, error) {
+	_, err := db.ExecContext(ctx, "DELETE FROM FOLLOWS WHERE Follower = $1 AND Followee = $2", req.Follower, req.Followee)
+	if err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to unfollow: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) ListFollowing(ctx context.Context, req *pb.TListFollowingRequest) (*pb.TListFollowingResponse, error) {
+	rows, err := db.QueryContext(ctx, "SELECT Followee FROM FOLLOWS WHERE Follower = $1 ORDER BY Followee", req.Login)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch followees: %v", err)
+	}
+	defer rows.Close()
+
+	response := &pb.TListFollowingResponse{}
+	for rows.Next() {
+		var login string
+		if err := rows.Scan(&login); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan followee: %v", err)
+		}
+		response.Logins = append(response.Logins, login)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating over rows: %v", err)
+	}
+	return response, nil
+}
+
+// Follows are deleted on unfollow, so the growth is counted over the follows that are still there.
+func (s *server) GetFollowerGrowth(ctx context.Context, req *pb.TGetFollowerGrowthRequest) (*pb.TFollowerGrowth, error) {
+	if req.From == nil || req.To == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "range should have both ends")
+	}
+	from, to := req.From.AsTime(), req.To.AsTime()
+	previousFrom := from.Add(-to.Sub(from))
+
+	response := &pb.TFollowerGrowth{}
+	err := db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE CreatedAt >= $2 AND CreatedAt < $3),
+			COUNT(*) FILTER (WHERE CreatedAt >= $4 AND CreatedAt < $2)
+		FROM FOLLOWS
+		WHERE Followee = $1`,
+		req.Login, from, to, previousFrom,
+	).Scan(&response.Followers, &response.Gained, &response.PreviouslyGained)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count followers: %v", err)
+	}
+	return response, nil
+}
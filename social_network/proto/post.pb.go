@@ -10,6 +10,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,347 +23,670 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type TPost struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type TPost_EStatus int32
 
-	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Content     string `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
-	AuthorLogin string `protobuf:"bytes,4,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
-}
+const (
+	TPost_PUBLISHED TPost_EStatus = 0
+	TPost_DRAFT     TPost_EStatus = 1
+	TPost_SCHEDULED TPost_EStatus = 2
+)
 
-func (x *TPost) Reset() {
-	*x = TPost{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for TPost_EStatus.
+var (
+	TPost_EStatus_name = map[int32]string{
+		0: "PUBLISHED",
+		1: "DRAFT",
+		2: "SCHEDULED",
 	}
-}
+	TPost_EStatus_value = map[string]int32{
+		"PUBLISHED": 0,
+		"DRAFT":     1,
+		"SCHEDULED": 2,
+	}
+)
 
-func (x *TPost) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x TPost_EStatus) Enum() *TPost_EStatus {
+	p := new(TPost_EStatus)
+	*p = x
+	return p
 }
 
-func (*TPost) ProtoMessage() {}
+func (x TPost_EStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *TPost) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (TPost_EStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[0].Descriptor()
 }
 
-// Deprecated: Use TPost.ProtoReflect.Descriptor instead.
-func (*TPost) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{0}
+func (TPost_EStatus) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[0]
 }
 
-func (x *TPost) GetPostId() uint64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (x TPost_EStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TPost) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use TPost_EStatus.Descriptor instead.
+func (TPost_EStatus) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{0, 0}
 }
 
-func (x *TPost) GetContent() string {
-	if x != nil {
-		return x.Content
+type TPost_EVisibility int32
+
+const (
+	TPost_PUBLIC TPost_EVisibility = 0
+	// Visible to the author's followers only
+	TPost_FOLLOWERS TPost_EVisibility = 1
+	// Visible to anyone who knows its id, but never listed
+	TPost_UNLISTED TPost_EVisibility = 2
+	TPost_PRIVATE  TPost_EVisibility = 3
+)
+
+// Enum value maps for TPost_EVisibility.
+var (
+	TPost_EVisibility_name = map[int32]string{
+		0: "PUBLIC",
+		1: "FOLLOWERS",
+		2: "UNLISTED",
+		3: "PRIVATE",
 	}
-	return ""
+	TPost_EVisibility_value = map[string]int32{
+		"PUBLIC":    0,
+		"FOLLOWERS": 1,
+		"UNLISTED":  2,
+		"PRIVATE":   3,
+	}
+)
+
+func (x TPost_EVisibility) Enum() *TPost_EVisibility {
+	p := new(TPost_EVisibility)
+	*p = x
+	return p
 }
 
-func (x *TPost) GetAuthorLogin() string {
-	if x != nil {
-		return x.AuthorLogin
-	}
-	return ""
+func (x TPost_EVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type TPostStats struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (TPost_EVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[1].Descriptor()
+}
 
-	PostId uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
-	Viewed uint64 `protobuf:"varint,2,opt,name=Viewed,proto3" json:"Viewed,omitempty"`
-	Liked  uint64 `protobuf:"varint,3,opt,name=Liked,proto3" json:"Liked,omitempty"`
+func (TPost_EVisibility) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[1]
 }
 
-func (x *TPostStats) Reset() {
-	*x = TPostStats{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x TPost_EVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TPostStats) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use TPost_EVisibility.Descriptor instead.
+func (TPost_EVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{0, 1}
 }
 
-func (*TPostStats) ProtoMessage() {}
+type TPost_EKind int32
 
-func (x *TPostStats) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	TPost_ORIGINAL TPost_EKind = 0
+	// Shares the referenced post as is, has no title or content of its own
+	TPost_REPOST TPost_EKind = 1
+	// Shares the referenced post with commentary
+	TPost_QUOTE TPost_EKind = 2
+)
+
+// Enum value maps for TPost_EKind.
+var (
+	TPost_EKind_name = map[int32]string{
+		0: "ORIGINAL",
+		1: "REPOST",
+		2: "QUOTE",
 	}
-	return mi.MessageOf(x)
-}
+	TPost_EKind_value = map[string]int32{
+		"ORIGINAL": 0,
+		"REPOST":   1,
+		"QUOTE":    2,
+	}
+)
 
-// Deprecated: Use TPostStats.ProtoReflect.Descriptor instead.
-func (*TPostStats) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{1}
+func (x TPost_EKind) Enum() *TPost_EKind {
+	p := new(TPost_EKind)
+	*p = x
+	return p
 }
 
-func (x *TPostStats) GetPostId() uint64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (x TPost_EKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *TPostStats) GetViewed() uint64 {
-	if x != nil {
-		return x.Viewed
-	}
-	return 0
+func (TPost_EKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[2].Descriptor()
 }
 
-func (x *TPostStats) GetLiked() uint64 {
-	if x != nil {
-		return x.Liked
-	}
-	return 0
+func (TPost_EKind) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[2]
 }
 
-type TCreatePostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x TPost_EKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	Title       string `protobuf:"bytes,1,opt,name=Title,proto3" json:"Title,omitempty"`
-	Content     string `protobuf:"bytes,2,opt,name=Content,proto3" json:"Content,omitempty"`
-	AuthorLogin string `protobuf:"bytes,3,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+// Deprecated: Use TPost_EKind.Descriptor instead.
+func (TPost_EKind) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{0, 2}
 }
 
-func (x *TCreatePostRequest) Reset() {
-	*x = TCreatePostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type TImageVariant_EName int32
+
+const (
+	TImageVariant_ORIGINAL  TImageVariant_EName = 0
+	TImageVariant_MEDIUM    TImageVariant_EName = 1
+	TImageVariant_THUMBNAIL TImageVariant_EName = 2
+)
+
+// Enum value maps for TImageVariant_EName.
+var (
+	TImageVariant_EName_name = map[int32]string{
+		0: "ORIGINAL",
+		1: "MEDIUM",
+		2: "THUMBNAIL",
 	}
+	TImageVariant_EName_value = map[string]int32{
+		"ORIGINAL":  0,
+		"MEDIUM":    1,
+		"THUMBNAIL": 2,
+	}
+)
+
+func (x TImageVariant_EName) Enum() *TImageVariant_EName {
+	p := new(TImageVariant_EName)
+	*p = x
+	return p
 }
 
-func (x *TCreatePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x TImageVariant_EName) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*TCreatePostRequest) ProtoMessage() {}
+func (TImageVariant_EName) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[3].Descriptor()
+}
 
-func (x *TCreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (TImageVariant_EName) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[3]
 }
 
-// Deprecated: Use TCreatePostRequest.ProtoReflect.Descriptor instead.
-func (*TCreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{2}
+func (x TImageVariant_EName) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TCreatePostRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use TImageVariant_EName.Descriptor instead.
+func (TImageVariant_EName) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{5, 0}
 }
 
-func (x *TCreatePostRequest) GetContent() string {
-	if x != nil {
-		return x.Content
+type TImageVariant_EState int32
+
+const (
+	TImageVariant_PENDING TImageVariant_EState = 0
+	TImageVariant_READY   TImageVariant_EState = 1
+	TImageVariant_FAILED  TImageVariant_EState = 2
+)
+
+// Enum value maps for TImageVariant_EState.
+var (
+	TImageVariant_EState_name = map[int32]string{
+		0: "PENDING",
+		1: "READY",
+		2: "FAILED",
 	}
-	return ""
+	TImageVariant_EState_value = map[string]int32{
+		"PENDING": 0,
+		"READY":   1,
+		"FAILED":  2,
+	}
+)
+
+func (x TImageVariant_EState) Enum() *TImageVariant_EState {
+	p := new(TImageVariant_EState)
+	*p = x
+	return p
 }
 
-func (x *TCreatePostRequest) GetAuthorLogin() string {
-	if x != nil {
-		return x.AuthorLogin
-	}
-	return ""
+func (x TImageVariant_EState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type TCreatePostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (TImageVariant_EState) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[4].Descriptor()
+}
 
-	PostId *uint64 `protobuf:"varint,1,opt,name=PostId,proto3,oneof" json:"PostId,omitempty"`
+func (TImageVariant_EState) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[4]
 }
 
-func (x *TCreatePostResponse) Reset() {
-	*x = TCreatePostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x TImageVariant_EState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TCreatePostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use TImageVariant_EState.Descriptor instead.
+func (TImageVariant_EState) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{5, 1}
 }
 
-func (*TCreatePostResponse) ProtoMessage() {}
+type TDiffLine_EOp int32
 
-func (x *TCreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	TDiffLine_EQUAL  TDiffLine_EOp = 0
+	TDiffLine_INSERT TDiffLine_EOp = 1
+	TDiffLine_DELETE TDiffLine_EOp = 2
+)
+
+// Enum value maps for TDiffLine_EOp.
+var (
+	TDiffLine_EOp_name = map[int32]string{
+		0: "EQUAL",
+		1: "INSERT",
+		2: "DELETE",
 	}
-	return mi.MessageOf(x)
-}
+	TDiffLine_EOp_value = map[string]int32{
+		"EQUAL":  0,
+		"INSERT": 1,
+		"DELETE": 2,
+	}
+)
 
-// Deprecated: Use TCreatePostResponse.ProtoReflect.Descriptor instead.
-func (*TCreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{3}
+func (x TDiffLine_EOp) Enum() *TDiffLine_EOp {
+	p := new(TDiffLine_EOp)
+	*p = x
+	return p
 }
 
-func (x *TCreatePostResponse) GetPostId() uint64 {
-	if x != nil && x.PostId != nil {
-		return *x.PostId
-	}
-	return 0
+func (x TDiffLine_EOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type TUpdatePostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (TDiffLine_EOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[5].Descriptor()
+}
 
-	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Content     string `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
-	AuthorLogin string `protobuf:"bytes,4,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+func (TDiffLine_EOp) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[5]
 }
 
-func (x *TUpdatePostRequest) Reset() {
-	*x = TUpdatePostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x TDiffLine_EOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TUpdatePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use TDiffLine_EOp.Descriptor instead.
+func (TDiffLine_EOp) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{25, 0}
 }
 
-func (*TUpdatePostRequest) ProtoMessage() {}
+type TPostEvent_EType int32
 
-func (x *TUpdatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	TPostEvent_UNKNOWN            TPostEvent_EType = 0
+	TPostEvent_DELETED            TPostEvent_EType = 1
+	TPostEvent_TAGS_CHANGED       TPostEvent_EType = 2
+	TPostEvent_PUBLISHED          TPostEvent_EType = 3
+	TPostEvent_VISIBILITY_CHANGED TPostEvent_EType = 4
+	TPostEvent_REPOSTED           TPostEvent_EType = 5
+	TPostEvent_QUOTED             TPostEvent_EType = 6
+	TPostEvent_MENTIONED          TPostEvent_EType = 7
+)
+
+// Enum value maps for TPostEvent_EType.
+var (
+	TPostEvent_EType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "DELETED",
+		2: "TAGS_CHANGED",
+		3: "PUBLISHED",
+		4: "VISIBILITY_CHANGED",
+		5: "REPOSTED",
+		6: "QUOTED",
+		7: "MENTIONED",
 	}
-	return mi.MessageOf(x)
+	TPostEvent_EType_value = map[string]int32{
+		"UNKNOWN":            0,
+		"DELETED":            1,
+		"TAGS_CHANGED":       2,
+		"PUBLISHED":          3,
+		"VISIBILITY_CHANGED": 4,
+		"REPOSTED":           5,
+		"QUOTED":             6,
+		"MENTIONED":          7,
+	}
+)
+
+func (x TPostEvent_EType) Enum() *TPostEvent_EType {
+	p := new(TPostEvent_EType)
+	*p = x
+	return p
 }
 
-// Deprecated: Use TUpdatePostRequest.ProtoReflect.Descriptor instead.
-func (*TUpdatePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{4}
+func (x TPostEvent_EType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *TUpdatePostRequest) GetPostId() uint64 {
-	if x != nil {
-		return x.PostId
+func (TPostEvent_EType) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[6].Descriptor()
+}
+
+func (TPostEvent_EType) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[6]
+}
+
+func (x TPostEvent_EType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TPostEvent_EType.Descriptor instead.
+func (TPostEvent_EType) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{32, 0}
+}
+
+type TListCommentsRequest_EOrder int32
+
+const (
+	TListCommentsRequest_NEWEST TListCommentsRequest_EOrder = 0
+	TListCommentsRequest_OLDEST TListCommentsRequest_EOrder = 1
+	TListCommentsRequest_TOP    TListCommentsRequest_EOrder = 2
+)
+
+// Enum value maps for TListCommentsRequest_EOrder.
+var (
+	TListCommentsRequest_EOrder_name = map[int32]string{
+		0: "NEWEST",
+		1: "OLDEST",
+		2: "TOP",
 	}
-	return 0
+	TListCommentsRequest_EOrder_value = map[string]int32{
+		"NEWEST": 0,
+		"OLDEST": 1,
+		"TOP":    2,
+	}
+)
+
+func (x TListCommentsRequest_EOrder) Enum() *TListCommentsRequest_EOrder {
+	p := new(TListCommentsRequest_EOrder)
+	*p = x
+	return p
 }
 
-func (x *TUpdatePostRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x TListCommentsRequest_EOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TListCommentsRequest_EOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[7].Descriptor()
+}
+
+func (TListCommentsRequest_EOrder) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[7]
+}
+
+func (x TListCommentsRequest_EOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TListCommentsRequest_EOrder.Descriptor instead.
+func (TListCommentsRequest_EOrder) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{38, 0}
+}
+
+type TNotification_EType int32
+
+const (
+	TNotification_UNKNOWN   TNotification_EType = 0
+	TNotification_REPOSTED  TNotification_EType = 1
+	TNotification_QUOTED    TNotification_EType = 2
+	TNotification_MENTIONED TNotification_EType = 3
+)
+
+// Enum value maps for TNotification_EType.
+var (
+	TNotification_EType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "REPOSTED",
+		2: "QUOTED",
+		3: "MENTIONED",
 	}
-	return ""
+	TNotification_EType_value = map[string]int32{
+		"UNKNOWN":   0,
+		"REPOSTED":  1,
+		"QUOTED":    2,
+		"MENTIONED": 3,
+	}
+)
+
+func (x TNotification_EType) Enum() *TNotification_EType {
+	p := new(TNotification_EType)
+	*p = x
+	return p
 }
 
-func (x *TUpdatePostRequest) GetContent() string {
-	if x != nil {
-		return x.Content
+func (x TNotification_EType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TNotification_EType) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[8].Descriptor()
+}
+
+func (TNotification_EType) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[8]
+}
+
+func (x TNotification_EType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TNotification_EType.Descriptor instead.
+func (TNotification_EType) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{51, 0}
+}
+
+type TGetPostTimeSeriesRequest_EBucket int32
+
+const (
+	TGetPostTimeSeriesRequest_HOUR   TGetPostTimeSeriesRequest_EBucket = 0
+	TGetPostTimeSeriesRequest_MINUTE TGetPostTimeSeriesRequest_EBucket = 1
+	TGetPostTimeSeriesRequest_DAY    TGetPostTimeSeriesRequest_EBucket = 2
+)
+
+// Enum value maps for TGetPostTimeSeriesRequest_EBucket.
+var (
+	TGetPostTimeSeriesRequest_EBucket_name = map[int32]string{
+		0: "HOUR",
+		1: "MINUTE",
+		2: "DAY",
 	}
-	return ""
+	TGetPostTimeSeriesRequest_EBucket_value = map[string]int32{
+		"HOUR":   0,
+		"MINUTE": 1,
+		"DAY":    2,
+	}
+)
+
+func (x TGetPostTimeSeriesRequest_EBucket) Enum() *TGetPostTimeSeriesRequest_EBucket {
+	p := new(TGetPostTimeSeriesRequest_EBucket)
+	*p = x
+	return p
 }
 
-func (x *TUpdatePostRequest) GetAuthorLogin() string {
-	if x != nil {
-		return x.AuthorLogin
+func (x TGetPostTimeSeriesRequest_EBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TGetPostTimeSeriesRequest_EBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[9].Descriptor()
+}
+
+func (TGetPostTimeSeriesRequest_EBucket) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[9]
+}
+
+func (x TGetPostTimeSeriesRequest_EBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TGetPostTimeSeriesRequest_EBucket.Descriptor instead.
+func (TGetPostTimeSeriesRequest_EBucket) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{59, 0}
+}
+
+type TGetTopPostsRequest_EOrderBy int32
+
+const (
+	TGetTopPostsRequest_LIKES          TGetTopPostsRequest_EOrderBy = 0
+	TGetTopPostsRequest_VIEWS          TGetTopPostsRequest_EOrderBy = 1
+	TGetTopPostsRequest_UNIQUE_VIEWERS TGetTopPostsRequest_EOrderBy = 2
+	// Likes per view
+	TGetTopPostsRequest_ENGAGEMENT_RATE TGetTopPostsRequest_EOrderBy = 3
+)
+
+// Enum value maps for TGetTopPostsRequest_EOrderBy.
+var (
+	TGetTopPostsRequest_EOrderBy_name = map[int32]string{
+		0: "LIKES",
+		1: "VIEWS",
+		2: "UNIQUE_VIEWERS",
+		3: "ENGAGEMENT_RATE",
 	}
-	return ""
+	TGetTopPostsRequest_EOrderBy_value = map[string]int32{
+		"LIKES":           0,
+		"VIEWS":           1,
+		"UNIQUE_VIEWERS":  2,
+		"ENGAGEMENT_RATE": 3,
+	}
+)
+
+func (x TGetTopPostsRequest_EOrderBy) Enum() *TGetTopPostsRequest_EOrderBy {
+	p := new(TGetTopPostsRequest_EOrderBy)
+	*p = x
+	return p
 }
 
-type TDeletePostRequest struct {
+func (x TGetTopPostsRequest_EOrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TGetTopPostsRequest_EOrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[10].Descriptor()
+}
+
+func (TGetTopPostsRequest_EOrderBy) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[10]
+}
+
+func (x TGetTopPostsRequest_EOrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TGetTopPostsRequest_EOrderBy.Descriptor instead.
+func (TGetTopPostsRequest_EOrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{65, 0}
+}
+
+type TTimeWindow_EPreset int32
+
+const (
+	TTimeWindow_ALL_TIME  TTimeWindow_EPreset = 0
+	TTimeWindow_LAST_HOUR TTimeWindow_EPreset = 1
+	TTimeWindow_LAST_DAY  TTimeWindow_EPreset = 2
+	TTimeWindow_LAST_WEEK TTimeWindow_EPreset = 3
+)
+
+// Enum value maps for TTimeWindow_EPreset.
+var (
+	TTimeWindow_EPreset_name = map[int32]string{
+		0: "ALL_TIME",
+		1: "LAST_HOUR",
+		2: "LAST_DAY",
+		3: "LAST_WEEK",
+	}
+	TTimeWindow_EPreset_value = map[string]int32{
+		"ALL_TIME":  0,
+		"LAST_HOUR": 1,
+		"LAST_DAY":  2,
+		"LAST_WEEK": 3,
+	}
+)
+
+func (x TTimeWindow_EPreset) Enum() *TTimeWindow_EPreset {
+	p := new(TTimeWindow_EPreset)
+	*p = x
+	return p
+}
+
+func (x TTimeWindow_EPreset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TTimeWindow_EPreset) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[11].Descriptor()
+}
+
+func (TTimeWindow_EPreset) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[11]
+}
+
+func (x TTimeWindow_EPreset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TTimeWindow_EPreset.Descriptor instead.
+func (TTimeWindow_EPreset) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{66, 0}
+}
+
+type TPost struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
-	AuthorLogin string `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	PostId      uint64                 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Content     string                 `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
+	AuthorLogin string                 `protobuf:"bytes,4,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	Version     uint64                 `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
+	Tags        []string               `protobuf:"bytes,6,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Attachments []*TAttachment         `protobuf:"bytes,8,rep,name=Attachments,proto3" json:"Attachments,omitempty"`
+	Status      TPost_EStatus          `protobuf:"varint,9,opt,name=Status,proto3,enum=post.TPost_EStatus" json:"Status,omitempty"`
+	// When the post was or is going to be published, empty for drafts
+	PublishAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=PublishAt,proto3" json:"PublishAt,omitempty"`
+	Visibility TPost_EVisibility      `protobuf:"varint,11,opt,name=Visibility,proto3,enum=post.TPost_EVisibility" json:"Visibility,omitempty"`
+	Kind       TPost_EKind            `protobuf:"varint,12,opt,name=Kind,proto3,enum=post.TPost_EKind" json:"Kind,omitempty"`
+	// Set for reposts and quotes
+	Reference *TPostReference `protobuf:"bytes,13,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Poll      *TPoll          `protobuf:"bytes,14,opt,name=Poll,proto3" json:"Poll,omitempty"`
+	// Registered users mentioned as @login in the content
+	Mentions []string `protobuf:"bytes,15,rep,name=Mentions,proto3" json:"Mentions,omitempty"`
+	// Filled in by main_service from stats_service
+	LikedByViewer bool `protobuf:"varint,16,opt,name=LikedByViewer,proto3" json:"LikedByViewer,omitempty"`
 }
 
-func (x *TDeletePostRequest) Reset() {
-	*x = TDeletePostRequest{}
+func (x *TPost) Reset() {
+	*x = TPost{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[5]
+		mi := &file_post_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TDeletePostRequest) String() string {
+func (x *TPost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TDeletePostRequest) ProtoMessage() {}
+func (*TPost) ProtoMessage() {}
 
-func (x *TDeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[5]
+func (x *TPost) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,97 +697,150 @@ func (x *TDeletePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TDeletePostRequest.ProtoReflect.Descriptor instead.
-func (*TDeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use TPost.ProtoReflect.Descriptor instead.
+func (*TPost) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *TDeletePostRequest) GetPostId() uint64 {
+func (x *TPost) GetPostId() uint64 {
 	if x != nil {
 		return x.PostId
 	}
 	return 0
 }
 
-func (x *TDeletePostRequest) GetAuthorLogin() string {
+func (x *TPost) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TPost) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TPost) GetAuthorLogin() string {
 	if x != nil {
 		return x.AuthorLogin
 	}
 	return ""
 }
 
-type TGetPostByIdRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TPost) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
 
-	PostId uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+func (x *TPost) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-func (x *TGetPostByIdRequest) Reset() {
-	*x = TGetPostByIdRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TPost) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
+	return nil
 }
 
-func (x *TGetPostByIdRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TPost) GetAttachments() []*TAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
 }
 
-func (*TGetPostByIdRequest) ProtoMessage() {}
+func (x *TPost) GetStatus() TPost_EStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TPost_PUBLISHED
+}
 
-func (x *TGetPostByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TPost) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use TGetPostByIdRequest.ProtoReflect.Descriptor instead.
-func (*TGetPostByIdRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{6}
+func (x *TPost) GetVisibility() TPost_EVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return TPost_PUBLIC
 }
 
-func (x *TGetPostByIdRequest) GetPostId() uint64 {
+func (x *TPost) GetKind() TPost_EKind {
 	if x != nil {
-		return x.PostId
+		return x.Kind
 	}
-	return 0
+	return TPost_ORIGINAL
 }
 
-type TGetPostByIdResponse struct {
+func (x *TPost) GetReference() *TPostReference {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *TPost) GetPoll() *TPoll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *TPost) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *TPost) GetLikedByViewer() bool {
+	if x != nil {
+		return x.LikedByViewer
+	}
+	return false
+}
+
+type TPoll struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *TPost `protobuf:"bytes,1,opt,name=Post,proto3,oneof" json:"Post,omitempty"`
+	Options []*TPoll_TOption `protobuf:"bytes,1,rep,name=Options,proto3" json:"Options,omitempty"`
+	// Empty for polls that never close
+	ClosesAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ClosesAt,proto3" json:"ClosesAt,omitempty"`
 }
 
-func (x *TGetPostByIdResponse) Reset() {
-	*x = TGetPostByIdResponse{}
+func (x *TPoll) Reset() {
+	*x = TPoll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[7]
+		mi := &file_post_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TGetPostByIdResponse) String() string {
+func (x *TPoll) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TGetPostByIdResponse) ProtoMessage() {}
+func (*TPoll) ProtoMessage() {}
 
-func (x *TGetPostByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[7]
+func (x *TPoll) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,43 +851,54 @@ func (x *TGetPostByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TGetPostByIdResponse.ProtoReflect.Descriptor instead.
-func (*TGetPostByIdResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use TPoll.ProtoReflect.Descriptor instead.
+func (*TPoll) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *TGetPostByIdResponse) GetPost() *TPost {
+func (x *TPoll) GetOptions() []*TPoll_TOption {
 	if x != nil {
-		return x.Post
+		return x.Options
 	}
 	return nil
 }
 
-type TGetPostsOnPageRequest struct {
+func (x *TPoll) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+// Sent by main_service to PollVotesTopic, the latest vote of the user counts
+type TPollVote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageId uint64 `protobuf:"varint,1,opt,name=PageId,proto3" json:"PageId,omitempty"`
+	PostId   uint64                 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	Login    string                 `protobuf:"bytes,2,opt,name=Login,proto3" json:"Login,omitempty"`
+	OptionId uint32                 `protobuf:"varint,3,opt,name=OptionId,proto3" json:"OptionId,omitempty"`
+	VotedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=VotedAt,proto3" json:"VotedAt,omitempty"`
 }
 
-func (x *TGetPostsOnPageRequest) Reset() {
-	*x = TGetPostsOnPageRequest{}
+func (x *TPollVote) Reset() {
+	*x = TPollVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[8]
+		mi := &file_post_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TGetPostsOnPageRequest) String() string {
+func (x *TPollVote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TGetPostsOnPageRequest) ProtoMessage() {}
+func (*TPollVote) ProtoMessage() {}
 
-func (x *TGetPostsOnPageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[8]
+func (x *TPollVote) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,43 +909,68 @@ func (x *TGetPostsOnPageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TGetPostsOnPageRequest.ProtoReflect.Descriptor instead.
-func (*TGetPostsOnPageRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use TPollVote.ProtoReflect.Descriptor instead.
+func (*TPollVote) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *TGetPostsOnPageRequest) GetPageId() uint64 {
+func (x *TPollVote) GetPostId() uint64 {
 	if x != nil {
-		return x.PageId
+		return x.PostId
 	}
 	return 0
 }
 
-type TGetPostsOnPageResponse struct {
+func (x *TPollVote) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *TPollVote) GetOptionId() uint32 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *TPollVote) GetVotedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VotedAt
+	}
+	return nil
+}
+
+type TPostReference struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Posts []*TPost `protobuf:"bytes,1,rep,name=Posts,proto3" json:"Posts,omitempty"`
+	PostId uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	// Empty when the post is deleted or no longer public
+	Post *TPost `protobuf:"bytes,2,opt,name=Post,proto3" json:"Post,omitempty"`
+	// Tombstone of a post that can not be shown anymore
+	Deleted bool `protobuf:"varint,3,opt,name=Deleted,proto3" json:"Deleted,omitempty"`
 }
 
-func (x *TGetPostsOnPageResponse) Reset() {
-	*x = TGetPostsOnPageResponse{}
+func (x *TPostReference) Reset() {
+	*x = TPostReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[9]
+		mi := &file_post_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TGetPostsOnPageResponse) String() string {
+func (x *TPostReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TGetPostsOnPageResponse) ProtoMessage() {}
+func (*TPostReference) ProtoMessage() {}
 
-func (x *TGetPostsOnPageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[9]
+func (x *TPostReference) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,43 +981,69 @@ func (x *TGetPostsOnPageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TGetPostsOnPageResponse.ProtoReflect.Descriptor instead.
-func (*TGetPostsOnPageResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TPostReference.ProtoReflect.Descriptor instead.
+func (*TPostReference) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *TGetPostsOnPageResponse) GetPosts() []*TPost {
+func (x *TPostReference) GetPostId() uint64 {
 	if x != nil {
-		return x.Posts
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TPostReference) GetPost() *TPost {
+	if x != nil {
+		return x.Post
 	}
 	return nil
 }
 
-type TGetPostStatsRequest struct {
+func (x *TPostReference) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type TAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
-}
-
-func (x *TGetPostStatsRequest) Reset() {
-	*x = TGetPostStatsRequest{}
+	AttachmentId uint64 `protobuf:"varint,1,opt,name=AttachmentId,proto3" json:"AttachmentId,omitempty"`
+	PostId       uint64 `protobuf:"varint,2,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	FileName     string `protobuf:"bytes,3,opt,name=FileName,proto3" json:"FileName,omitempty"`
+	ContentType  string `protobuf:"bytes,4,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	Size         uint64 `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`
+	// Key of the file in the blob storage
+	BlobKey   string                 `protobuf:"bytes,6,opt,name=BlobKey,proto3" json:"BlobKey,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	// Filled in for images once the original is processed
+	Width    uint32           `protobuf:"varint,8,opt,name=Width,proto3" json:"Width,omitempty"`
+	Height   uint32           `protobuf:"varint,9,opt,name=Height,proto3" json:"Height,omitempty"`
+	BlurHash string           `protobuf:"bytes,10,opt,name=BlurHash,proto3" json:"BlurHash,omitempty"`
+	Variants []*TImageVariant `protobuf:"bytes,11,rep,name=Variants,proto3" json:"Variants,omitempty"`
+}
+
+func (x *TAttachment) Reset() {
+	*x = TAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[10]
+		mi := &file_post_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TGetPostStatsRequest) String() string {
+func (x *TAttachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TGetPostStatsRequest) ProtoMessage() {}
+func (*TAttachment) ProtoMessage() {}
 
-func (x *TGetPostStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[10]
+func (x *TAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,106 +1054,120 @@ func (x *TGetPostStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TGetPostStatsRequest.ProtoReflect.Descriptor instead.
-func (*TGetPostStatsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use TAttachment.ProtoReflect.Descriptor instead.
+func (*TAttachment) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *TGetPostStatsRequest) GetPostId() uint64 {
+func (x *TAttachment) GetAttachmentId() uint64 {
 	if x != nil {
-		return x.PostId
+		return x.AttachmentId
 	}
 	return 0
 }
 
-type TGetPostStatsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PostId uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
-	Likes  uint64 `protobuf:"varint,2,opt,name=Likes,proto3" json:"Likes,omitempty"`
-	Views  uint64 `protobuf:"varint,3,opt,name=Views,proto3" json:"Views,omitempty"`
+func (x *TAttachment) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
 }
 
-func (x *TGetPostStatsResponse) Reset() {
-	*x = TGetPostStatsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TAttachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
 	}
+	return ""
 }
 
-func (x *TGetPostStatsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TAttachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
 }
 
-func (*TGetPostStatsResponse) ProtoMessage() {}
+func (x *TAttachment) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
 
-func (x *TGetPostStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TAttachment) GetBlobKey() string {
+	if x != nil {
+		return x.BlobKey
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TGetPostStatsResponse.ProtoReflect.Descriptor instead.
-func (*TGetPostStatsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{11}
+func (x *TAttachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *TGetPostStatsResponse) GetPostId() uint64 {
+func (x *TAttachment) GetWidth() uint32 {
 	if x != nil {
-		return x.PostId
+		return x.Width
 	}
 	return 0
 }
 
-func (x *TGetPostStatsResponse) GetLikes() uint64 {
+func (x *TAttachment) GetHeight() uint32 {
 	if x != nil {
-		return x.Likes
+		return x.Height
 	}
 	return 0
 }
 
-func (x *TGetPostStatsResponse) GetViews() uint64 {
+func (x *TAttachment) GetBlurHash() string {
 	if x != nil {
-		return x.Views
+		return x.BlurHash
 	}
-	return 0
+	return ""
 }
 
-type TGetTopPostsRequest struct {
+func (x *TAttachment) GetVariants() []*TImageVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// Re-encoded copy of an image attachment without EXIF metadata
+type TImageVariant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderBy string `protobuf:"bytes,1,opt,name=OrderBy,proto3" json:"OrderBy,omitempty"`
+	Name        TImageVariant_EName  `protobuf:"varint,1,opt,name=Name,proto3,enum=post.TImageVariant_EName" json:"Name,omitempty"`
+	State       TImageVariant_EState `protobuf:"varint,2,opt,name=State,proto3,enum=post.TImageVariant_EState" json:"State,omitempty"`
+	Width       uint32               `protobuf:"varint,3,opt,name=Width,proto3" json:"Width,omitempty"`
+	Height      uint32               `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
+	Size        uint64               `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`
+	ContentType string               `protobuf:"bytes,6,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	BlobKey     string               `protobuf:"bytes,7,opt,name=BlobKey,proto3" json:"BlobKey,omitempty"`
 }
 
-func (x *TGetTopPostsRequest) Reset() {
-	*x = TGetTopPostsRequest{}
+func (x *TImageVariant) Reset() {
+	*x = TImageVariant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[12]
+		mi := &file_post_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TGetTopPostsRequest) String() string {
+func (x *TImageVariant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TGetTopPostsRequest) ProtoMessage() {}
+func (*TImageVariant) ProtoMessage() {}
 
-func (x *TGetTopPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[12]
+func (x *TImageVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,90 +1178,88 @@ func (x *TGetTopPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TGetTopPostsRequest.ProtoReflect.Descriptor instead.
-func (*TGetTopPostsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use TImageVariant.ProtoReflect.Descriptor instead.
+func (*TImageVariant) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *TGetTopPostsRequest) GetOrderBy() string {
+func (x *TImageVariant) GetName() TImageVariant_EName {
 	if x != nil {
-		return x.OrderBy
+		return x.Name
 	}
-	return ""
+	return TImageVariant_ORIGINAL
 }
 
-type TGetTopPostsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Posts []*TGetTopPostsResponse_TPostStat `protobuf:"bytes,1,rep,name=Posts,proto3" json:"Posts,omitempty"`
+func (x *TImageVariant) GetState() TImageVariant_EState {
+	if x != nil {
+		return x.State
+	}
+	return TImageVariant_PENDING
 }
 
-func (x *TGetTopPostsResponse) Reset() {
-	*x = TGetTopPostsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TImageVariant) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
 	}
+	return 0
 }
 
-func (x *TGetTopPostsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TImageVariant) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
 }
 
-func (*TGetTopPostsResponse) ProtoMessage() {}
-
-func (x *TGetTopPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TImageVariant) GetSize() uint64 {
+	if x != nil {
+		return x.Size
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TGetTopPostsResponse.ProtoReflect.Descriptor instead.
-func (*TGetTopPostsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{13}
+func (x *TImageVariant) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
 }
 
-func (x *TGetTopPostsResponse) GetPosts() []*TGetTopPostsResponse_TPostStat {
+func (x *TImageVariant) GetBlobKey() string {
 	if x != nil {
-		return x.Posts
+		return x.BlobKey
 	}
-	return nil
+	return ""
 }
 
-type TGetTopAuthorsResponse struct {
+// Asks the image worker to build variants of an uploaded image
+type TImageJob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authors []*TGetTopAuthorsResponse_TAuthor `protobuf:"bytes,1,rep,name=Authors,proto3" json:"Authors,omitempty"`
+	AttachmentId uint64 `protobuf:"varint,1,opt,name=AttachmentId,proto3" json:"AttachmentId,omitempty"`
+	BlobKey      string `protobuf:"bytes,2,opt,name=BlobKey,proto3" json:"BlobKey,omitempty"`
+	ContentType  string `protobuf:"bytes,3,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
 }
 
-func (x *TGetTopAuthorsResponse) Reset() {
-	*x = TGetTopAuthorsResponse{}
+func (x *TImageJob) Reset() {
+	*x = TImageJob{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[14]
+		mi := &file_post_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TGetTopAuthorsResponse) String() string {
+func (x *TImageJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TGetTopAuthorsResponse) ProtoMessage() {}
+func (*TImageJob) ProtoMessage() {}
 
-func (x *TGetTopAuthorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[14]
+func (x *TImageJob) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,44 +1270,64 @@ func (x *TGetTopAuthorsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TGetTopAuthorsResponse.ProtoReflect.Descriptor instead.
-func (*TGetTopAuthorsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use TImageJob.ProtoReflect.Descriptor instead.
+func (*TImageJob) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *TGetTopAuthorsResponse) GetAuthors() []*TGetTopAuthorsResponse_TAuthor {
+func (x *TImageJob) GetAttachmentId() uint64 {
 	if x != nil {
-		return x.Authors
+		return x.AttachmentId
 	}
-	return nil
+	return 0
 }
 
-type TAddPostRequest struct {
+func (x *TImageJob) GetBlobKey() string {
+	if x != nil {
+		return x.BlobKey
+	}
+	return ""
+}
+
+func (x *TImageJob) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type TPostStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
-	AuthorLogin string `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	PostId uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	Viewed uint64 `protobuf:"varint,2,opt,name=Viewed,proto3" json:"Viewed,omitempty"`
+	Liked  uint64 `protobuf:"varint,3,opt,name=Liked,proto3" json:"Liked,omitempty"`
+	// The user who viewed or liked the post. Views are counted both in total and per user,
+	// likes are counted once per user, the latest of Liked and Unliked wins
+	Login     string                 `protobuf:"bytes,4,opt,name=Login,proto3" json:"Login,omitempty"`
+	Unliked   uint64                 `protobuf:"varint,5,opt,name=Unliked,proto3" json:"Unliked,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 }
 
-func (x *TAddPostRequest) Reset() {
-	*x = TAddPostRequest{}
+func (x *TPostStats) Reset() {
+	*x = TPostStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[15]
+		mi := &file_post_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TAddPostRequest) String() string {
+func (x *TPostStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TAddPostRequest) ProtoMessage() {}
+func (*TPostStats) ProtoMessage() {}
 
-func (x *TAddPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[15]
+func (x *TPostStats) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,53 +1338,92 @@ func (x *TAddPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TAddPostRequest.ProtoReflect.Descriptor instead.
-func (*TAddPostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use TPostStats.ProtoReflect.Descriptor instead.
+func (*TPostStats) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TAddPostRequest) GetPostId() uint64 {
+func (x *TPostStats) GetPostId() uint64 {
 	if x != nil {
 		return x.PostId
 	}
 	return 0
 }
 
-func (x *TAddPostRequest) GetAuthorLogin() string {
+func (x *TPostStats) GetViewed() uint64 {
 	if x != nil {
-		return x.AuthorLogin
+		return x.Viewed
 	}
-	return ""
+	return 0
 }
 
-type TGetTopPostsResponse_TPostStat struct {
-	state         protoimpl.MessageState
+func (x *TPostStats) GetLiked() uint64 {
+	if x != nil {
+		return x.Liked
+	}
+	return 0
+}
+
+func (x *TPostStats) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *TPostStats) GetUnliked() uint64 {
+	if x != nil {
+		return x.Unliked
+	}
+	return 0
+}
+
+func (x *TPostStats) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type TCreatePostRequest struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
-	AuthorLogin string `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
-	Likes       uint64 `protobuf:"varint,3,opt,name=Likes,proto3" json:"Likes,omitempty"`
-	Views       uint64 `protobuf:"varint,4,opt,name=Views,proto3" json:"Views,omitempty"`
+	Title       string `protobuf:"bytes,1,opt,name=Title,proto3" json:"Title,omitempty"`
+	Content     string `protobuf:"bytes,2,opt,name=Content,proto3" json:"Content,omitempty"`
+	AuthorLogin string `protobuf:"bytes,3,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	// Only DRAFT and SCHEDULED can be requested explicitly, posts are published right away by default
+	Status TPost_EStatus `protobuf:"varint,4,opt,name=Status,proto3,enum=post.TPost_EStatus" json:"Status,omitempty"`
+	// Required for SCHEDULED posts
+	PublishAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=PublishAt,proto3" json:"PublishAt,omitempty"`
+	Visibility TPost_EVisibility      `protobuf:"varint,6,opt,name=Visibility,proto3,enum=post.TPost_EVisibility" json:"Visibility,omitempty"`
+	// REPOST and QUOTE require RefPostId of a public post and are published right away
+	Kind      TPost_EKind `protobuf:"varint,7,opt,name=Kind,proto3,enum=post.TPost_EKind" json:"Kind,omitempty"`
+	RefPostId uint64      `protobuf:"varint,8,opt,name=RefPostId,proto3" json:"RefPostId,omitempty"`
+	// 2 to 10 options, option ids are assigned by post_service
+	Poll *TPoll `protobuf:"bytes,9,opt,name=Poll,proto3" json:"Poll,omitempty"`
+	// Registered users mentioned in Content, resolved by main_service
+	Mentions []string `protobuf:"bytes,10,rep,name=Mentions,proto3" json:"Mentions,omitempty"`
 }
 
-func (x *TGetTopPostsResponse_TPostStat) Reset() {
-	*x = TGetTopPostsResponse_TPostStat{}
+func (x *TCreatePostRequest) Reset() {
+	*x = TCreatePostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[16]
+		mi := &file_post_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TGetTopPostsResponse_TPostStat) String() string {
+func (x *TCreatePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TGetTopPostsResponse_TPostStat) ProtoMessage() {}
+func (*TCreatePostRequest) ProtoMessage() {}
 
-func (x *TGetTopPostsResponse_TPostStat) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[16]
+func (x *TCreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,65 +1434,163 @@ func (x *TGetTopPostsResponse_TPostStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TGetTopPostsResponse_TPostStat.ProtoReflect.Descriptor instead.
-func (*TGetTopPostsResponse_TPostStat) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use TCreatePostRequest.ProtoReflect.Descriptor instead.
+func (*TCreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TGetTopPostsResponse_TPostStat) GetPostId() uint64 {
+func (x *TCreatePostRequest) GetTitle() string {
 	if x != nil {
-		return x.PostId
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *TGetTopPostsResponse_TPostStat) GetAuthorLogin() string {
+func (x *TCreatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TCreatePostRequest) GetAuthorLogin() string {
 	if x != nil {
 		return x.AuthorLogin
 	}
 	return ""
 }
 
-func (x *TGetTopPostsResponse_TPostStat) GetLikes() uint64 {
+func (x *TCreatePostRequest) GetStatus() TPost_EStatus {
 	if x != nil {
-		return x.Likes
+		return x.Status
+	}
+	return TPost_PUBLISHED
+}
+
+func (x *TCreatePostRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *TCreatePostRequest) GetVisibility() TPost_EVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return TPost_PUBLIC
+}
+
+func (x *TCreatePostRequest) GetKind() TPost_EKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TPost_ORIGINAL
+}
+
+func (x *TCreatePostRequest) GetRefPostId() uint64 {
+	if x != nil {
+		return x.RefPostId
 	}
 	return 0
 }
 
-func (x *TGetTopPostsResponse_TPostStat) GetViews() uint64 {
+func (x *TCreatePostRequest) GetPoll() *TPoll {
 	if x != nil {
-		return x.Views
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *TCreatePostRequest) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+type TCreatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId *uint64 `protobuf:"varint,1,opt,name=PostId,proto3,oneof" json:"PostId,omitempty"`
+}
+
+func (x *TCreatePostResponse) Reset() {
+	*x = TCreatePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TCreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCreatePostResponse) ProtoMessage() {}
+
+func (x *TCreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCreatePostResponse.ProtoReflect.Descriptor instead.
+func (*TCreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TCreatePostResponse) GetPostId() uint64 {
+	if x != nil && x.PostId != nil {
+		return *x.PostId
 	}
 	return 0
 }
 
-type TGetTopAuthorsResponse_TAuthor struct {
+type TUpdatePostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthorLogin string `protobuf:"bytes,1,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
-	Likes       uint64 `protobuf:"varint,2,opt,name=Likes,proto3" json:"Likes,omitempty"`
+	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Content     string `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
+	AuthorLogin string `protobuf:"bytes,4,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	// Version of the post the edit is based on, the update is rejected if the post changed since
+	ExpectedVersion uint64 `protobuf:"varint,5,opt,name=ExpectedVersion,proto3" json:"ExpectedVersion,omitempty"`
+	// Fields of TPost to update (Title, Content, Visibility), Title and Content if empty
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=UpdateMask,proto3" json:"UpdateMask,omitempty"`
+	Visibility TPost_EVisibility      `protobuf:"varint,7,opt,name=Visibility,proto3,enum=post.TPost_EVisibility" json:"Visibility,omitempty"`
+	// Registered users mentioned in Content, replaces the mentions of the post along with the content
+	Mentions []string `protobuf:"bytes,8,rep,name=Mentions,proto3" json:"Mentions,omitempty"`
 }
 
-func (x *TGetTopAuthorsResponse_TAuthor) Reset() {
-	*x = TGetTopAuthorsResponse_TAuthor{}
+func (x *TUpdatePostRequest) Reset() {
+	*x = TUpdatePostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[17]
+		mi := &file_post_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TGetTopAuthorsResponse_TAuthor) String() string {
+func (x *TUpdatePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TGetTopAuthorsResponse_TAuthor) ProtoMessage() {}
+func (*TUpdatePostRequest) ProtoMessage() {}
 
-func (x *TGetTopAuthorsResponse_TAuthor) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[17]
+func (x *TUpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -992,238 +1601,6459 @@ func (x *TGetTopAuthorsResponse_TAuthor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TGetTopAuthorsResponse_TAuthor.ProtoReflect.Descriptor instead.
-func (*TGetTopAuthorsResponse_TAuthor) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{14, 0}
+// Deprecated: Use TUpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*TUpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TGetTopAuthorsResponse_TAuthor) GetAuthorLogin() string {
+func (x *TUpdatePostRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TUpdatePostRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TUpdatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TUpdatePostRequest) GetAuthorLogin() string {
 	if x != nil {
 		return x.AuthorLogin
 	}
 	return ""
 }
 
-func (x *TGetTopAuthorsResponse_TAuthor) GetLikes() uint64 {
+func (x *TUpdatePostRequest) GetExpectedVersion() uint64 {
 	if x != nil {
-		return x.Likes
+		return x.ExpectedVersion
 	}
 	return 0
 }
 
-var File_post_proto protoreflect.FileDescriptor
+func (x *TUpdatePostRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
 
-var file_post_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x6f,
-	0x73, 0x74, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x71, 0x0a, 0x05, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x22, 0x52, 0x0a, 0x0a, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x69, 0x65, 0x77,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x66, 0x0a, 0x12, 0x54, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x3d,
-	0x0a, 0x13, 0x54, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x88,
-	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x7e, 0x0a,
-	0x12, 0x54, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x4e, 0x0a,
-	0x12, 0x54, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x2d, 0x0a,
-	0x13, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x14,
-	0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x48,
-	0x00, 0x52, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50,
-	0x6f, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x16, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
-	0x4f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x50, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50,
-	0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x17, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x73, 0x4f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x21, 0x0a, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x50, 0x6f,
-	0x73, 0x74, 0x73, 0x22, 0x2e, 0x0a, 0x14, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50,
-	0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73,
-	0x74, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x15, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69,
-	0x65, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73,
-	0x22, 0x2f, 0x0a, 0x13, 0x54, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x42, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
-	0x79, 0x22, 0xc5, 0x01, 0x0a, 0x14, 0x54, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x50, 0x6f,
-	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x54, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52,
-	0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x1a, 0x71, 0x0a, 0x09, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a,
-	0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x4c, 0x69,
-	0x6b, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x54, 0x47,
-	0x65, 0x74, 0x54, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x54, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x07, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x73, 0x1a, 0x41, 0x0a, 0x07, 0x54, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
-	0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x0f, 0x54, 0x41, 0x64, 0x64, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f,
-	0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x32, 0xef, 0x02, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x54, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x54, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x19, 0x2e, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x73, 0x4f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa6, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74,
-	0x73, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x54,
-	0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15,
-	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42,
-	0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x3b, 0x70, 0x6f, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+func (x *TUpdatePostRequest) GetVisibility() TPost_EVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return TPost_PUBLIC
 }
 
-var (
-	file_post_proto_rawDescOnce sync.Once
-	file_post_proto_rawDescData = file_post_proto_rawDesc
-)
+func (x *TUpdatePostRequest) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
 
-func file_post_proto_rawDescGZIP() []byte {
-	file_post_proto_rawDescOnce.Do(func() {
-		file_post_proto_rawDescData = protoimpl.X.CompressGZIP(file_post_proto_rawDescData)
-	})
-	return file_post_proto_rawDescData
+type TUpdatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version uint64 `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
 }
 
-var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
-var file_post_proto_goTypes = []interface{}{
-	(*TPost)(nil),                          // 0: post.TPost
-	(*TPostStats)(nil),                     // 1: post.TPostStats
-	(*TCreatePostRequest)(nil),             // 2: post.TCreatePostRequest
-	(*TCreatePostResponse)(nil),            // 3: post.TCreatePostResponse
-	(*TUpdatePostRequest)(nil),             // 4: post.TUpdatePostRequest
-	(*TDeletePostRequest)(nil),             // 5: post.TDeletePostRequest
-	(*TGetPostByIdRequest)(nil),            // 6: post.TGetPostByIdRequest
-	(*TGetPostByIdResponse)(nil),           // 7: post.TGetPostByIdResponse
-	(*TGetPostsOnPageRequest)(nil),         // 8: post.TGetPostsOnPageRequest
-	(*TGetPostsOnPageResponse)(nil),        // 9: post.TGetPostsOnPageResponse
-	(*TGetPostStatsRequest)(nil),           // 10: post.TGetPostStatsRequest
-	(*TGetPostStatsResponse)(nil),          // 11: post.TGetPostStatsResponse
-	(*TGetTopPostsRequest)(nil),            // 12: post.TGetTopPostsRequest
-	(*TGetTopPostsResponse)(nil),           // 13: post.TGetTopPostsResponse
-	(*TGetTopAuthorsResponse)(nil),         // 14: post.TGetTopAuthorsResponse
-	(*TAddPostRequest)(nil),                // 15: post.TAddPostRequest
-	(*TGetTopPostsResponse_TPostStat)(nil), // 16: post.TGetTopPostsResponse.TPostStat
-	(*TGetTopAuthorsResponse_TAuthor)(nil), // 17: post.TGetTopAuthorsResponse.TAuthor
-	(*emptypb.Empty)(nil),                  // 18: google.protobuf.Empty
+func (x *TUpdatePostResponse) Reset() {
+	*x = TUpdatePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_post_proto_depIdxs = []int32{
-	0,  // 0: post.TGetPostByIdResponse.Post:type_name -> post.TPost
-	0,  // 1: post.TGetPostsOnPageResponse.Posts:type_name -> post.TPost
-	16, // 2: post.TGetTopPostsResponse.Posts:type_name -> post.TGetTopPostsResponse.TPostStat
-	17, // 3: post.TGetTopAuthorsResponse.Authors:type_name -> post.TGetTopAuthorsResponse.TAuthor
-	2,  // 4: post.PostService.CreatePost:input_type -> post.TCreatePostRequest
-	4,  // 5: post.PostService.UpdatePost:input_type -> post.TUpdatePostRequest
-	5,  // 6: post.PostService.DeletePost:input_type -> post.TDeletePostRequest
-	6,  // 7: post.PostService.GetPostById:input_type -> post.TGetPostByIdRequest
-	8,  // 8: post.PostService.GetPostsOnPage:input_type -> post.TGetPostsOnPageRequest
-	10, // 9: post.StatsService.GetPostStats:input_type -> post.TGetPostStatsRequest
-	12, // 10: post.StatsService.GetTopPosts:input_type -> post.TGetTopPostsRequest
-	18, // 11: post.StatsService.GetTopAuthors:input_type -> google.protobuf.Empty
-	15, // 12: post.StatsService.AddPost:input_type -> post.TAddPostRequest
-	3,  // 13: post.PostService.CreatePost:output_type -> post.TCreatePostResponse
-	18, // 14: post.PostService.UpdatePost:output_type -> google.protobuf.Empty
-	18, // 15: post.PostService.DeletePost:output_type -> google.protobuf.Empty
-	7,  // 16: post.PostService.GetPostById:output_type -> post.TGetPostByIdResponse
-	9,  // 17: post.PostService.GetPostsOnPage:output_type -> post.TGetPostsOnPageResponse
-	11, // 18: post.StatsService.GetPostStats:output_type -> post.TGetPostStatsResponse
-	13, // 19: post.StatsService.GetTopPosts:output_type -> post.TGetTopPostsResponse
-	14, // 20: post.StatsService.GetTopAuthors:output_type -> post.TGetTopAuthorsResponse
-	18, // 21: post.StatsService.AddPost:output_type -> google.protobuf.Empty
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+func (x *TUpdatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_post_proto_init() }
-func file_post_proto_init() {
-	if File_post_proto != nil {
-		return
+func (*TUpdatePostResponse) ProtoMessage() {}
+
+func (x *TUpdatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_post_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TPost); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TUpdatePostResponse.ProtoReflect.Descriptor instead.
+func (*TUpdatePostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TUpdatePostResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type TDeletePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	AuthorLogin string `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+}
+
+func (x *TDeletePostRequest) Reset() {
+	*x = TDeletePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDeletePostRequest) ProtoMessage() {}
+
+func (x *TDeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDeletePostRequest.ProtoReflect.Descriptor instead.
+func (*TDeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TDeletePostRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TDeletePostRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+type TGetPostByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	// Unpublished posts are visible to their author only
+	ViewerLogin string `protobuf:"bytes,2,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+}
+
+func (x *TGetPostByIdRequest) Reset() {
+	*x = TGetPostByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostByIdRequest) ProtoMessage() {}
+
+func (x *TGetPostByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostByIdRequest.ProtoReflect.Descriptor instead.
+func (*TGetPostByIdRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TGetPostByIdRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TGetPostByIdRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+type TGetPostByIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *TPost `protobuf:"bytes,1,opt,name=Post,proto3,oneof" json:"Post,omitempty"`
+}
+
+func (x *TGetPostByIdResponse) Reset() {
+	*x = TGetPostByIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostByIdResponse) ProtoMessage() {}
+
+func (x *TGetPostByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostByIdResponse.ProtoReflect.Descriptor instead.
+func (*TGetPostByIdResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TGetPostByIdResponse) GetPost() *TPost {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type TGetPostsOnPageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageId      uint64 `protobuf:"varint,1,opt,name=PageId,proto3" json:"PageId,omitempty"`
+	ViewerLogin string `protobuf:"bytes,2,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+}
+
+func (x *TGetPostsOnPageRequest) Reset() {
+	*x = TGetPostsOnPageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostsOnPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostsOnPageRequest) ProtoMessage() {}
+
+func (x *TGetPostsOnPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostsOnPageRequest.ProtoReflect.Descriptor instead.
+func (*TGetPostsOnPageRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TGetPostsOnPageRequest) GetPageId() uint64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *TGetPostsOnPageRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+type TGetPostsOnPageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*TPost `protobuf:"bytes,1,rep,name=Posts,proto3" json:"Posts,omitempty"`
+}
+
+func (x *TGetPostsOnPageResponse) Reset() {
+	*x = TGetPostsOnPageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostsOnPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostsOnPageResponse) ProtoMessage() {}
+
+func (x *TGetPostsOnPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostsOnPageResponse.ProtoReflect.Descriptor instead.
+func (*TGetPostsOnPageResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TGetPostsOnPageResponse) GetPosts() []*TPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+// Publishes a draft or scheduled post at PublishAt, or right away if it is not in the future
+type TPublishPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      uint64                 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	AuthorLogin string                 `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	PublishAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=PublishAt,proto3" json:"PublishAt,omitempty"`
+}
+
+func (x *TPublishPostRequest) Reset() {
+	*x = TPublishPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TPublishPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TPublishPostRequest) ProtoMessage() {}
+
+func (x *TPublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TPublishPostRequest.ProtoReflect.Descriptor instead.
+func (*TPublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TPublishPostRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TPublishPostRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TPublishPostRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type TListDraftsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorLogin string `protobuf:"bytes,1,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+}
+
+func (x *TListDraftsRequest) Reset() {
+	*x = TListDraftsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListDraftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListDraftsRequest) ProtoMessage() {}
+
+func (x *TListDraftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListDraftsRequest.ProtoReflect.Descriptor instead.
+func (*TListDraftsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TListDraftsRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+type TPostRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RevisionId  uint64                 `protobuf:"varint,1,opt,name=RevisionId,proto3" json:"RevisionId,omitempty"`
+	PostId      uint64                 `protobuf:"varint,2,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
+	Content     string                 `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
+	AuthorLogin string                 `protobuf:"bytes,5,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *TPostRevision) Reset() {
+	*x = TPostRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TPostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TPostRevision) ProtoMessage() {}
+
+func (x *TPostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TPostRevision.ProtoReflect.Descriptor instead.
+func (*TPostRevision) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TPostRevision) GetRevisionId() uint64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+func (x *TPostRevision) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TPostRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TPostRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TPostRevision) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TPostRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TListPostRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	ViewerLogin string `protobuf:"bytes,2,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+}
+
+func (x *TListPostRevisionsRequest) Reset() {
+	*x = TListPostRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListPostRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListPostRevisionsRequest) ProtoMessage() {}
+
+func (x *TListPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*TListPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TListPostRevisionsRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TListPostRevisionsRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+type TListPostRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*TPostRevision `protobuf:"bytes,1,rep,name=Revisions,proto3" json:"Revisions,omitempty"`
+}
+
+func (x *TListPostRevisionsResponse) Reset() {
+	*x = TListPostRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListPostRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListPostRevisionsResponse) ProtoMessage() {}
+
+func (x *TListPostRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListPostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*TListPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TListPostRevisionsResponse) GetRevisions() []*TPostRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type TGetPostRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	RevisionId  uint64 `protobuf:"varint,2,opt,name=RevisionId,proto3" json:"RevisionId,omitempty"`
+	ViewerLogin string `protobuf:"bytes,3,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+}
+
+func (x *TGetPostRevisionRequest) Reset() {
+	*x = TGetPostRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostRevisionRequest) ProtoMessage() {}
+
+func (x *TGetPostRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*TGetPostRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TGetPostRevisionRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TGetPostRevisionRequest) GetRevisionId() uint64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+func (x *TGetPostRevisionRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+type TGetPostRevisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision *TPostRevision `protobuf:"bytes,1,opt,name=Revision,proto3,oneof" json:"Revision,omitempty"`
+}
+
+func (x *TGetPostRevisionResponse) Reset() {
+	*x = TGetPostRevisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostRevisionResponse) ProtoMessage() {}
+
+func (x *TGetPostRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostRevisionResponse.ProtoReflect.Descriptor instead.
+func (*TGetPostRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TGetPostRevisionResponse) GetRevision() *TPostRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type TDiffPostRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId         uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	FromRevisionId uint64 `protobuf:"varint,2,opt,name=FromRevisionId,proto3" json:"FromRevisionId,omitempty"`
+	ToRevisionId   uint64 `protobuf:"varint,3,opt,name=ToRevisionId,proto3" json:"ToRevisionId,omitempty"`
+	ViewerLogin    string `protobuf:"bytes,4,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+}
+
+func (x *TDiffPostRevisionsRequest) Reset() {
+	*x = TDiffPostRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDiffPostRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDiffPostRevisionsRequest) ProtoMessage() {}
+
+func (x *TDiffPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDiffPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*TDiffPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TDiffPostRevisionsRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TDiffPostRevisionsRequest) GetFromRevisionId() uint64 {
+	if x != nil {
+		return x.FromRevisionId
+	}
+	return 0
+}
+
+func (x *TDiffPostRevisionsRequest) GetToRevisionId() uint64 {
+	if x != nil {
+		return x.ToRevisionId
+	}
+	return 0
+}
+
+func (x *TDiffPostRevisionsRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+type TDiffLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op   TDiffLine_EOp `protobuf:"varint,1,opt,name=Op,proto3,enum=post.TDiffLine_EOp" json:"Op,omitempty"`
+	Text string        `protobuf:"bytes,2,opt,name=Text,proto3" json:"Text,omitempty"`
+}
+
+func (x *TDiffLine) Reset() {
+	*x = TDiffLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDiffLine) ProtoMessage() {}
+
+func (x *TDiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDiffLine.ProtoReflect.Descriptor instead.
+func (*TDiffLine) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TDiffLine) GetOp() TDiffLine_EOp {
+	if x != nil {
+		return x.Op
+	}
+	return TDiffLine_EQUAL
+}
+
+func (x *TDiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type TDiffPostRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title   []*TDiffLine `protobuf:"bytes,1,rep,name=Title,proto3" json:"Title,omitempty"`
+	Content []*TDiffLine `protobuf:"bytes,2,rep,name=Content,proto3" json:"Content,omitempty"`
+}
+
+func (x *TDiffPostRevisionsResponse) Reset() {
+	*x = TDiffPostRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDiffPostRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDiffPostRevisionsResponse) ProtoMessage() {}
+
+func (x *TDiffPostRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDiffPostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*TDiffPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TDiffPostRevisionsResponse) GetTitle() []*TDiffLine {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *TDiffPostRevisionsResponse) GetContent() []*TDiffLine {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type TRestorePostRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	RevisionId  uint64 `protobuf:"varint,2,opt,name=RevisionId,proto3" json:"RevisionId,omitempty"`
+	AuthorLogin string `protobuf:"bytes,3,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+}
+
+func (x *TRestorePostRevisionRequest) Reset() {
+	*x = TRestorePostRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TRestorePostRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TRestorePostRevisionRequest) ProtoMessage() {}
+
+func (x *TRestorePostRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TRestorePostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*TRestorePostRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TRestorePostRevisionRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TRestorePostRevisionRequest) GetRevisionId() uint64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+func (x *TRestorePostRevisionRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+type TTrashedPost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post      *TPost                 `protobuf:"bytes,1,opt,name=Post,proto3" json:"Post,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=DeletedAt,proto3" json:"DeletedAt,omitempty"`
+	PurgeAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=PurgeAt,proto3" json:"PurgeAt,omitempty"`
+}
+
+func (x *TTrashedPost) Reset() {
+	*x = TTrashedPost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTrashedPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTrashedPost) ProtoMessage() {}
+
+func (x *TTrashedPost) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTrashedPost.ProtoReflect.Descriptor instead.
+func (*TTrashedPost) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TTrashedPost) GetPost() *TPost {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *TTrashedPost) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TTrashedPost) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type TListTrashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorLogin string `protobuf:"bytes,1,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+}
+
+func (x *TListTrashRequest) Reset() {
+	*x = TListTrashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListTrashRequest) ProtoMessage() {}
+
+func (x *TListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListTrashRequest.ProtoReflect.Descriptor instead.
+func (*TListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TListTrashRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+type TListTrashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*TTrashedPost `protobuf:"bytes,1,rep,name=Posts,proto3" json:"Posts,omitempty"`
+}
+
+func (x *TListTrashResponse) Reset() {
+	*x = TListTrashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListTrashResponse) ProtoMessage() {}
+
+func (x *TListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListTrashResponse.ProtoReflect.Descriptor instead.
+func (*TListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TListTrashResponse) GetPosts() []*TTrashedPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type TRestorePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	AuthorLogin string `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+}
+
+func (x *TRestorePostRequest) Reset() {
+	*x = TRestorePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TRestorePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TRestorePostRequest) ProtoMessage() {}
+
+func (x *TRestorePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TRestorePostRequest.ProtoReflect.Descriptor instead.
+func (*TRestorePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TRestorePostRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TRestorePostRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+// Lifecycle events published by post_service to PostEventsTopic
+type TPostEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        TPostEvent_EType `protobuf:"varint,1,opt,name=Type,proto3,enum=post.TPostEvent_EType" json:"Type,omitempty"`
+	PostId      uint64           `protobuf:"varint,2,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	AuthorLogin string           `protobuf:"bytes,3,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	// Full set of the post's tags for TAGS_CHANGED
+	Tags []string `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	// For PUBLISHED and VISIBILITY_CHANGED, trashed posts are announced as PRIVATE until they are restored
+	Visibility TPost_EVisibility `protobuf:"varint,5,opt,name=Visibility,proto3,enum=post.TPost_EVisibility" json:"Visibility,omitempty"`
+	// The shared post for REPOSTED and QUOTED, PostId is the repost or quote itself
+	RefPostId uint64 `protobuf:"varint,6,opt,name=RefPostId,proto3" json:"RefPostId,omitempty"`
+	// Users to notify for MENTIONED, only the ones who can see the post and were not mentioned before
+	Mentions []string `protobuf:"bytes,7,rep,name=Mentions,proto3" json:"Mentions,omitempty"`
+}
+
+func (x *TPostEvent) Reset() {
+	*x = TPostEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TPostEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TPostEvent) ProtoMessage() {}
+
+func (x *TPostEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TPostEvent.ProtoReflect.Descriptor instead.
+func (*TPostEvent) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TPostEvent) GetType() TPostEvent_EType {
+	if x != nil {
+		return x.Type
+	}
+	return TPostEvent_UNKNOWN
+}
+
+func (x *TPostEvent) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TPostEvent) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TPostEvent) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TPostEvent) GetVisibility() TPost_EVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return TPost_PUBLIC
+}
+
+func (x *TPostEvent) GetRefPostId() uint64 {
+	if x != nil {
+		return x.RefPostId
+	}
+	return 0
+}
+
+func (x *TPostEvent) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+type TComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId uint64 `protobuf:"varint,1,opt,name=CommentId,proto3" json:"CommentId,omitempty"`
+	PostId    uint64 `protobuf:"varint,2,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	// 0 for top-level comments
+	ParentId    uint64 `protobuf:"varint,3,opt,name=ParentId,proto3" json:"ParentId,omitempty"`
+	Depth       uint32 `protobuf:"varint,4,opt,name=Depth,proto3" json:"Depth,omitempty"`
+	AuthorLogin string `protobuf:"bytes,5,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	// Empty for deleted comments, which are kept to preserve the thread
+	Content      string                 `protobuf:"bytes,6,opt,name=Content,proto3" json:"Content,omitempty"`
+	Deleted      bool                   `protobuf:"varint,7,opt,name=Deleted,proto3" json:"Deleted,omitempty"`
+	Likes        uint64                 `protobuf:"varint,8,opt,name=Likes,proto3" json:"Likes,omitempty"`
+	RepliesCount uint64                 `protobuf:"varint,9,opt,name=RepliesCount,proto3" json:"RepliesCount,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+}
+
+func (x *TComment) Reset() {
+	*x = TComment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TComment) ProtoMessage() {}
+
+func (x *TComment) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TComment.ProtoReflect.Descriptor instead.
+func (*TComment) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TComment) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *TComment) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TComment) GetParentId() uint64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *TComment) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *TComment) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TComment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TComment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *TComment) GetLikes() uint64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *TComment) GetRepliesCount() uint64 {
+	if x != nil {
+		return x.RepliesCount
+	}
+	return 0
+}
+
+func (x *TComment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TComment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type TCreateCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	ParentId    uint64 `protobuf:"varint,2,opt,name=ParentId,proto3" json:"ParentId,omitempty"`
+	AuthorLogin string `protobuf:"bytes,3,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	Content     string `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
+}
+
+func (x *TCreateCommentRequest) Reset() {
+	*x = TCreateCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TCreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCreateCommentRequest) ProtoMessage() {}
+
+func (x *TCreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*TCreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TCreateCommentRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TCreateCommentRequest) GetParentId() uint64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *TCreateCommentRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TCreateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type TUpdateCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId   uint64 `protobuf:"varint,1,opt,name=CommentId,proto3" json:"CommentId,omitempty"`
+	AuthorLogin string `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	Content     string `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
+}
+
+func (x *TUpdateCommentRequest) Reset() {
+	*x = TUpdateCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TUpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TUpdateCommentRequest) ProtoMessage() {}
+
+func (x *TUpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TUpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*TUpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TUpdateCommentRequest) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *TUpdateCommentRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TUpdateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type TCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *TComment `protobuf:"bytes,1,opt,name=Comment,proto3,oneof" json:"Comment,omitempty"`
+}
+
+func (x *TCommentResponse) Reset() {
+	*x = TCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCommentResponse) ProtoMessage() {}
+
+func (x *TCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCommentResponse.ProtoReflect.Descriptor instead.
+func (*TCommentResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TCommentResponse) GetComment() *TComment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type TDeleteCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId   uint64 `protobuf:"varint,1,opt,name=CommentId,proto3" json:"CommentId,omitempty"`
+	AuthorLogin string `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+}
+
+func (x *TDeleteCommentRequest) Reset() {
+	*x = TDeleteCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDeleteCommentRequest) ProtoMessage() {}
+
+func (x *TDeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*TDeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TDeleteCommentRequest) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *TDeleteCommentRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+type TListCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId   uint64                      `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	ParentId uint64                      `protobuf:"varint,2,opt,name=ParentId,proto3" json:"ParentId,omitempty"`
+	Order    TListCommentsRequest_EOrder `protobuf:"varint,3,opt,name=Order,proto3,enum=post.TListCommentsRequest_EOrder" json:"Order,omitempty"`
+	// NextCursor from the previous page, empty for the first one
+	Cursor      string `protobuf:"bytes,4,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	Limit       uint32 `protobuf:"varint,5,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	ViewerLogin string `protobuf:"bytes,6,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+}
+
+func (x *TListCommentsRequest) Reset() {
+	*x = TListCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListCommentsRequest) ProtoMessage() {}
+
+func (x *TListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*TListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TListCommentsRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TListCommentsRequest) GetParentId() uint64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *TListCommentsRequest) GetOrder() TListCommentsRequest_EOrder {
+	if x != nil {
+		return x.Order
+	}
+	return TListCommentsRequest_NEWEST
+}
+
+func (x *TListCommentsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *TListCommentsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TListCommentsRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+type TListCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments []*TComment `protobuf:"bytes,1,rep,name=Comments,proto3" json:"Comments,omitempty"`
+	// Empty when there are no more comments
+	NextCursor string `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+}
+
+func (x *TListCommentsResponse) Reset() {
+	*x = TListCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListCommentsResponse) ProtoMessage() {}
+
+func (x *TListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*TListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TListCommentsResponse) GetComments() []*TComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *TListCommentsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type TLikeCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId uint64 `protobuf:"varint,1,opt,name=CommentId,proto3" json:"CommentId,omitempty"`
+	Login     string `protobuf:"bytes,2,opt,name=Login,proto3" json:"Login,omitempty"`
+}
+
+func (x *TLikeCommentRequest) Reset() {
+	*x = TLikeCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLikeCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLikeCommentRequest) ProtoMessage() {}
+
+func (x *TLikeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLikeCommentRequest.ProtoReflect.Descriptor instead.
+func (*TLikeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TLikeCommentRequest) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *TLikeCommentRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type TGetPostsByTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag         string `protobuf:"bytes,1,opt,name=Tag,proto3" json:"Tag,omitempty"`
+	PageId      uint64 `protobuf:"varint,2,opt,name=PageId,proto3" json:"PageId,omitempty"`
+	ViewerLogin string `protobuf:"bytes,3,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+}
+
+func (x *TGetPostsByTagRequest) Reset() {
+	*x = TGetPostsByTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostsByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostsByTagRequest) ProtoMessage() {}
+
+func (x *TGetPostsByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostsByTagRequest.ProtoReflect.Descriptor instead.
+func (*TGetPostsByTagRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TGetPostsByTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TGetPostsByTagRequest) GetPageId() uint64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *TGetPostsByTagRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+type TSearchPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All words must match, "quoted phrases" match adjacent words,
+	// word* matches prefixes and -word excludes posts containing it
+	Query       string                 `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	AuthorLogin string                 `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=CreatedFrom,proto3" json:"CreatedFrom,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedTo,proto3" json:"CreatedTo,omitempty"`
+	PageId      uint64                 `protobuf:"varint,5,opt,name=PageId,proto3" json:"PageId,omitempty"`
+	ViewerLogin string                 `protobuf:"bytes,6,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+}
+
+func (x *TSearchPostsRequest) Reset() {
+	*x = TSearchPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSearchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSearchPostsRequest) ProtoMessage() {}
+
+func (x *TSearchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*TSearchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TSearchPostsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *TSearchPostsRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TSearchPostsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *TSearchPostsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *TSearchPostsRequest) GetPageId() uint64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *TSearchPostsRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+type TSearchPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hits []*TSearchPostsResponse_THit `protobuf:"bytes,1,rep,name=Hits,proto3" json:"Hits,omitempty"`
+}
+
+func (x *TSearchPostsResponse) Reset() {
+	*x = TSearchPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSearchPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSearchPostsResponse) ProtoMessage() {}
+
+func (x *TSearchPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSearchPostsResponse.ProtoReflect.Descriptor instead.
+func (*TSearchPostsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TSearchPostsResponse) GetHits() []*TSearchPostsResponse_THit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type TAddAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	AuthorLogin string `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	FileName    string `protobuf:"bytes,3,opt,name=FileName,proto3" json:"FileName,omitempty"`
+	ContentType string `protobuf:"bytes,4,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	Size        uint64 `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`
+	BlobKey     string `protobuf:"bytes,6,opt,name=BlobKey,proto3" json:"BlobKey,omitempty"`
+}
+
+func (x *TAddAttachmentRequest) Reset() {
+	*x = TAddAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TAddAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TAddAttachmentRequest) ProtoMessage() {}
+
+func (x *TAddAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TAddAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*TAddAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TAddAttachmentRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TAddAttachmentRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TAddAttachmentRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *TAddAttachmentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *TAddAttachmentRequest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TAddAttachmentRequest) GetBlobKey() string {
+	if x != nil {
+		return x.BlobKey
+	}
+	return ""
+}
+
+type TGetAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttachmentId uint64 `protobuf:"varint,1,opt,name=AttachmentId,proto3" json:"AttachmentId,omitempty"`
+	ViewerLogin  string `protobuf:"bytes,2,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+}
+
+func (x *TGetAttachmentRequest) Reset() {
+	*x = TGetAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetAttachmentRequest) ProtoMessage() {}
+
+func (x *TGetAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*TGetAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TGetAttachmentRequest) GetAttachmentId() uint64 {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return 0
+}
+
+func (x *TGetAttachmentRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+type TFollowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Follower string `protobuf:"bytes,1,opt,name=Follower,proto3" json:"Follower,omitempty"`
+	Followee string `protobuf:"bytes,2,opt,name=Followee,proto3" json:"Followee,omitempty"`
+}
+
+func (x *TFollowRequest) Reset() {
+	*x = TFollowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TFollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TFollowRequest) ProtoMessage() {}
+
+func (x *TFollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TFollowRequest.ProtoReflect.Descriptor instead.
+func (*TFollowRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TFollowRequest) GetFollower() string {
+	if x != nil {
+		return x.Follower
+	}
+	return ""
+}
+
+func (x *TFollowRequest) GetFollowee() string {
+	if x != nil {
+		return x.Followee
+	}
+	return ""
+}
+
+type TListFollowingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login string `protobuf:"bytes,1,opt,name=Login,proto3" json:"Login,omitempty"`
+}
+
+func (x *TListFollowingRequest) Reset() {
+	*x = TListFollowingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListFollowingRequest) ProtoMessage() {}
+
+func (x *TListFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListFollowingRequest.ProtoReflect.Descriptor instead.
+func (*TListFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TListFollowingRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type TListFollowingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logins []string `protobuf:"bytes,1,rep,name=Logins,proto3" json:"Logins,omitempty"`
+}
+
+func (x *TListFollowingResponse) Reset() {
+	*x = TListFollowingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListFollowingResponse) ProtoMessage() {}
+
+func (x *TListFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListFollowingResponse.ProtoReflect.Descriptor instead.
+func (*TListFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TListFollowingResponse) GetLogins() []string {
+	if x != nil {
+		return x.Logins
+	}
+	return nil
+}
+
+type TGetFollowerGrowthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login string                 `protobuf:"bytes,1,opt,name=Login,proto3" json:"Login,omitempty"`
+	From  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	To    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+}
+
+func (x *TGetFollowerGrowthRequest) Reset() {
+	*x = TGetFollowerGrowthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetFollowerGrowthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetFollowerGrowthRequest) ProtoMessage() {}
+
+func (x *TGetFollowerGrowthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetFollowerGrowthRequest.ProtoReflect.Descriptor instead.
+func (*TGetFollowerGrowthRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TGetFollowerGrowthRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *TGetFollowerGrowthRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TGetFollowerGrowthRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type TFollowerGrowth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Followers uint64 `protobuf:"varint,1,opt,name=Followers,proto3" json:"Followers,omitempty"`
+	// Followers gained within [From, To) and within the range of the same length
+	// right before, the ones that unfollowed since are not counted
+	Gained           uint64 `protobuf:"varint,2,opt,name=Gained,proto3" json:"Gained,omitempty"`
+	PreviouslyGained uint64 `protobuf:"varint,3,opt,name=PreviouslyGained,proto3" json:"PreviouslyGained,omitempty"`
+}
+
+func (x *TFollowerGrowth) Reset() {
+	*x = TFollowerGrowth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TFollowerGrowth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TFollowerGrowth) ProtoMessage() {}
+
+func (x *TFollowerGrowth) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TFollowerGrowth.ProtoReflect.Descriptor instead.
+func (*TFollowerGrowth) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TFollowerGrowth) GetFollowers() uint64 {
+	if x != nil {
+		return x.Followers
+	}
+	return 0
+}
+
+func (x *TFollowerGrowth) GetGained() uint64 {
+	if x != nil {
+		return x.Gained
+	}
+	return 0
+}
+
+func (x *TFollowerGrowth) GetPreviouslyGained() uint64 {
+	if x != nil {
+		return x.PreviouslyGained
+	}
+	return 0
+}
+
+type TNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotificationId uint64              `protobuf:"varint,1,opt,name=NotificationId,proto3" json:"NotificationId,omitempty"`
+	Type           TNotification_EType `protobuf:"varint,2,opt,name=Type,proto3,enum=post.TNotification_EType" json:"Type,omitempty"`
+	// Who caused the notification
+	ActorLogin string `protobuf:"bytes,3,opt,name=ActorLogin,proto3" json:"ActorLogin,omitempty"`
+	// The post of the actor, e.g. the repost
+	PostId uint64 `protobuf:"varint,4,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	// The post of the recipient, e.g. the reposted one
+	RefPostId uint64                 `protobuf:"varint,5,opt,name=RefPostId,proto3" json:"RefPostId,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *TNotification) Reset() {
+	*x = TNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TNotification) ProtoMessage() {}
+
+func (x *TNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TNotification.ProtoReflect.Descriptor instead.
+func (*TNotification) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TNotification) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *TNotification) GetType() TNotification_EType {
+	if x != nil {
+		return x.Type
+	}
+	return TNotification_UNKNOWN
+}
+
+func (x *TNotification) GetActorLogin() string {
+	if x != nil {
+		return x.ActorLogin
+	}
+	return ""
+}
+
+func (x *TNotification) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TNotification) GetRefPostId() uint64 {
+	if x != nil {
+		return x.RefPostId
+	}
+	return 0
+}
+
+func (x *TNotification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Published posts visible to the user that mention them, the newest go first
+type TListMentionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login  string `protobuf:"bytes,1,opt,name=Login,proto3" json:"Login,omitempty"`
+	PageId uint64 `protobuf:"varint,2,opt,name=PageId,proto3" json:"PageId,omitempty"`
+}
+
+func (x *TListMentionsRequest) Reset() {
+	*x = TListMentionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListMentionsRequest) ProtoMessage() {}
+
+func (x *TListMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListMentionsRequest.ProtoReflect.Descriptor instead.
+func (*TListMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TListMentionsRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *TListMentionsRequest) GetPageId() uint64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+type TListNotificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login  string `protobuf:"bytes,1,opt,name=Login,proto3" json:"Login,omitempty"`
+	PageId uint64 `protobuf:"varint,2,opt,name=PageId,proto3" json:"PageId,omitempty"`
+}
+
+func (x *TListNotificationsRequest) Reset() {
+	*x = TListNotificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListNotificationsRequest) ProtoMessage() {}
+
+func (x *TListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*TListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TListNotificationsRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *TListNotificationsRequest) GetPageId() uint64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+type TListNotificationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notifications []*TNotification `protobuf:"bytes,1,rep,name=Notifications,proto3" json:"Notifications,omitempty"`
+}
+
+func (x *TListNotificationsResponse) Reset() {
+	*x = TListNotificationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TListNotificationsResponse) ProtoMessage() {}
+
+func (x *TListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*TListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TListNotificationsResponse) GetNotifications() []*TNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type TDeleteAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttachmentId uint64 `protobuf:"varint,1,opt,name=AttachmentId,proto3" json:"AttachmentId,omitempty"`
+	AuthorLogin  string `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+}
+
+func (x *TDeleteAttachmentRequest) Reset() {
+	*x = TDeleteAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *TDeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*TDeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TDeleteAttachmentRequest) GetAttachmentId() uint64 {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return 0
+}
+
+func (x *TDeleteAttachmentRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+type TAttachmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attachment *TAttachment `protobuf:"bytes,1,opt,name=Attachment,proto3,oneof" json:"Attachment,omitempty"`
+}
+
+func (x *TAttachmentResponse) Reset() {
+	*x = TAttachmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TAttachmentResponse) ProtoMessage() {}
+
+func (x *TAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*TAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TAttachmentResponse) GetAttachment() *TAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type TGetPostStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+}
+
+func (x *TGetPostStatsRequest) Reset() {
+	*x = TGetPostStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostStatsRequest) ProtoMessage() {}
+
+func (x *TGetPostStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostStatsRequest.ProtoReflect.Descriptor instead.
+func (*TGetPostStatsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TGetPostStatsRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type TGetPostStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId  uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	Likes   uint64 `protobuf:"varint,2,opt,name=Likes,proto3" json:"Likes,omitempty"`
+	Views   uint64 `protobuf:"varint,3,opt,name=Views,proto3" json:"Views,omitempty"`
+	Reposts uint64 `protobuf:"varint,4,opt,name=Reposts,proto3" json:"Reposts,omitempty"`
+	Quotes  uint64 `protobuf:"varint,5,opt,name=Quotes,proto3" json:"Quotes,omitempty"`
+	// Approximate number of distinct users among Views
+	UniqueViewers uint64 `protobuf:"varint,6,opt,name=UniqueViewers,proto3" json:"UniqueViewers,omitempty"`
+}
+
+func (x *TGetPostStatsResponse) Reset() {
+	*x = TGetPostStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostStatsResponse) ProtoMessage() {}
+
+func (x *TGetPostStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostStatsResponse.ProtoReflect.Descriptor instead.
+func (*TGetPostStatsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *TGetPostStatsResponse) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TGetPostStatsResponse) GetLikes() uint64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *TGetPostStatsResponse) GetViews() uint64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *TGetPostStatsResponse) GetReposts() uint64 {
+	if x != nil {
+		return x.Reposts
+	}
+	return 0
+}
+
+func (x *TGetPostStatsResponse) GetQuotes() uint64 {
+	if x != nil {
+		return x.Quotes
+	}
+	return 0
+}
+
+func (x *TGetPostStatsResponse) GetUniqueViewers() uint64 {
+	if x != nil {
+		return x.UniqueViewers
+	}
+	return 0
+}
+
+type TGetPostTimeSeriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	// An empty range ends now and spans a few dozen buckets
+	From   *timestamppb.Timestamp            `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	To     *timestamppb.Timestamp            `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+	Bucket TGetPostTimeSeriesRequest_EBucket `protobuf:"varint,4,opt,name=Bucket,proto3,enum=post.TGetPostTimeSeriesRequest_EBucket" json:"Bucket,omitempty"`
+}
+
+func (x *TGetPostTimeSeriesRequest) Reset() {
+	*x = TGetPostTimeSeriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostTimeSeriesRequest) ProtoMessage() {}
+
+func (x *TGetPostTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*TGetPostTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TGetPostTimeSeriesRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TGetPostTimeSeriesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TGetPostTimeSeriesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TGetPostTimeSeriesRequest) GetBucket() TGetPostTimeSeriesRequest_EBucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return TGetPostTimeSeriesRequest_HOUR
+}
+
+type TGetPostTimeSeriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	// Every bucket of the range in order, including the empty ones
+	Buckets []*TGetPostTimeSeriesResponse_TBucket `protobuf:"bytes,2,rep,name=Buckets,proto3" json:"Buckets,omitempty"`
+}
+
+func (x *TGetPostTimeSeriesResponse) Reset() {
+	*x = TGetPostTimeSeriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostTimeSeriesResponse) ProtoMessage() {}
+
+func (x *TGetPostTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*TGetPostTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TGetPostTimeSeriesResponse) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TGetPostTimeSeriesResponse) GetBuckets() []*TGetPostTimeSeriesResponse_TBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type TGetPollResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	ViewerLogin string `protobuf:"bytes,2,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+}
+
+func (x *TGetPollResultsRequest) Reset() {
+	*x = TGetPollResultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPollResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPollResultsRequest) ProtoMessage() {}
+
+func (x *TGetPollResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPollResultsRequest.ProtoReflect.Descriptor instead.
+func (*TGetPollResultsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *TGetPollResultsRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TGetPollResultsRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+type TGetPollResultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options    []*TGetPollResultsResponse_TOption `protobuf:"bytes,1,rep,name=Options,proto3" json:"Options,omitempty"`
+	TotalVotes uint64                             `protobuf:"varint,2,opt,name=TotalVotes,proto3" json:"TotalVotes,omitempty"`
+	// 0 if the viewer has not voted
+	ViewerOptionId uint32 `protobuf:"varint,3,opt,name=ViewerOptionId,proto3" json:"ViewerOptionId,omitempty"`
+	// Filled in by main_service, votes are hidden until the viewer votes or the poll closes
+	Closed         bool `protobuf:"varint,4,opt,name=Closed,proto3" json:"Closed,omitempty"`
+	ResultsVisible bool `protobuf:"varint,5,opt,name=ResultsVisible,proto3" json:"ResultsVisible,omitempty"`
+}
+
+func (x *TGetPollResultsResponse) Reset() {
+	*x = TGetPollResultsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPollResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPollResultsResponse) ProtoMessage() {}
+
+func (x *TGetPollResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPollResultsResponse.ProtoReflect.Descriptor instead.
+func (*TGetPollResultsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *TGetPollResultsResponse) GetOptions() []*TGetPollResultsResponse_TOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *TGetPollResultsResponse) GetTotalVotes() uint64 {
+	if x != nil {
+		return x.TotalVotes
+	}
+	return 0
+}
+
+func (x *TGetPollResultsResponse) GetViewerOptionId() uint32 {
+	if x != nil {
+		return x.ViewerOptionId
+	}
+	return 0
+}
+
+func (x *TGetPollResultsResponse) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *TGetPollResultsResponse) GetResultsVisible() bool {
+	if x != nil {
+		return x.ResultsVisible
+	}
+	return false
+}
+
+type TGetViewerLikesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ViewerLogin string   `protobuf:"bytes,1,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+	PostIds     []uint64 `protobuf:"varint,2,rep,packed,name=PostIds,proto3" json:"PostIds,omitempty"`
+}
+
+func (x *TGetViewerLikesRequest) Reset() {
+	*x = TGetViewerLikesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetViewerLikesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetViewerLikesRequest) ProtoMessage() {}
+
+func (x *TGetViewerLikesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetViewerLikesRequest.ProtoReflect.Descriptor instead.
+func (*TGetViewerLikesRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *TGetViewerLikesRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+func (x *TGetViewerLikesRequest) GetPostIds() []uint64 {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+type TGetViewerLikesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The subset of the requested posts the viewer likes
+	LikedPostIds []uint64 `protobuf:"varint,1,rep,packed,name=LikedPostIds,proto3" json:"LikedPostIds,omitempty"`
+}
+
+func (x *TGetViewerLikesResponse) Reset() {
+	*x = TGetViewerLikesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetViewerLikesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetViewerLikesResponse) ProtoMessage() {}
+
+func (x *TGetViewerLikesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetViewerLikesResponse.ProtoReflect.Descriptor instead.
+func (*TGetViewerLikesResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TGetViewerLikesResponse) GetLikedPostIds() []uint64 {
+	if x != nil {
+		return x.LikedPostIds
+	}
+	return nil
+}
+
+type TGetTopPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderBy TGetTopPostsRequest_EOrderBy `protobuf:"varint,4,opt,name=OrderBy,proto3,enum=post.TGetTopPostsRequest_EOrderBy" json:"OrderBy,omitempty"`
+	// Only posts visible to the viewer are ranked
+	ViewerLogin string `protobuf:"bytes,2,opt,name=ViewerLogin,proto3" json:"ViewerLogin,omitempty"`
+	// Authors the viewer follows, lets followers-only posts into the ranking
+	Followees []string `protobuf:"bytes,3,rep,name=Followees,proto3" json:"Followees,omitempty"`
+	// 0 means the default of 3
+	Limit  uint32       `protobuf:"varint,5,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Window *TTimeWindow `protobuf:"bytes,6,opt,name=Window,proto3" json:"Window,omitempty"`
+	// Pages are Limit entries long
+	PageId uint64 `protobuf:"varint,7,opt,name=PageId,proto3" json:"PageId,omitempty"`
+}
+
+func (x *TGetTopPostsRequest) Reset() {
+	*x = TGetTopPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetTopPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetTopPostsRequest) ProtoMessage() {}
+
+func (x *TGetTopPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetTopPostsRequest.ProtoReflect.Descriptor instead.
+func (*TGetTopPostsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TGetTopPostsRequest) GetOrderBy() TGetTopPostsRequest_EOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return TGetTopPostsRequest_LIKES
+}
+
+func (x *TGetTopPostsRequest) GetViewerLogin() string {
+	if x != nil {
+		return x.ViewerLogin
+	}
+	return ""
+}
+
+func (x *TGetTopPostsRequest) GetFollowees() []string {
+	if x != nil {
+		return x.Followees
+	}
+	return nil
+}
+
+func (x *TGetTopPostsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TGetTopPostsRequest) GetWindow() *TTimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *TGetTopPostsRequest) GetPageId() uint64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+// Only views and likes made within the window are counted
+type TTimeWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preset TTimeWindow_EPreset `protobuf:"varint,1,opt,name=Preset,proto3,enum=post.TTimeWindow_EPreset" json:"Preset,omitempty"`
+	// An explicit range takes precedence over the preset, an empty end means now
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+}
+
+func (x *TTimeWindow) Reset() {
+	*x = TTimeWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTimeWindow) ProtoMessage() {}
+
+func (x *TTimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTimeWindow.ProtoReflect.Descriptor instead.
+func (*TTimeWindow) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *TTimeWindow) GetPreset() TTimeWindow_EPreset {
+	if x != nil {
+		return x.Preset
+	}
+	return TTimeWindow_ALL_TIME
+}
+
+func (x *TTimeWindow) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TTimeWindow) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type TGetTopPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*TGetTopPostsResponse_TPostStat `protobuf:"bytes,1,rep,name=Posts,proto3" json:"Posts,omitempty"`
+}
+
+func (x *TGetTopPostsResponse) Reset() {
+	*x = TGetTopPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetTopPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetTopPostsResponse) ProtoMessage() {}
+
+func (x *TGetTopPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetTopPostsResponse.ProtoReflect.Descriptor instead.
+func (*TGetTopPostsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *TGetTopPostsResponse) GetPosts() []*TGetTopPostsResponse_TPostStat {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type TGetTopAuthorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderBy TGetTopPostsRequest_EOrderBy `protobuf:"varint,1,opt,name=OrderBy,proto3,enum=post.TGetTopPostsRequest_EOrderBy" json:"OrderBy,omitempty"`
+	// 0 means the default of 3
+	Limit  uint32       `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Window *TTimeWindow `protobuf:"bytes,3,opt,name=Window,proto3" json:"Window,omitempty"`
+	PageId uint64       `protobuf:"varint,4,opt,name=PageId,proto3" json:"PageId,omitempty"`
+}
+
+func (x *TGetTopAuthorsRequest) Reset() {
+	*x = TGetTopAuthorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetTopAuthorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetTopAuthorsRequest) ProtoMessage() {}
+
+func (x *TGetTopAuthorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetTopAuthorsRequest.ProtoReflect.Descriptor instead.
+func (*TGetTopAuthorsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TGetTopAuthorsRequest) GetOrderBy() TGetTopPostsRequest_EOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return TGetTopPostsRequest_LIKES
+}
+
+func (x *TGetTopAuthorsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TGetTopAuthorsRequest) GetWindow() *TTimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *TGetTopAuthorsRequest) GetPageId() uint64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+type TGetTopAuthorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authors []*TGetTopAuthorsResponse_TAuthor `protobuf:"bytes,1,rep,name=Authors,proto3" json:"Authors,omitempty"`
+}
+
+func (x *TGetTopAuthorsResponse) Reset() {
+	*x = TGetTopAuthorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetTopAuthorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetTopAuthorsResponse) ProtoMessage() {}
+
+func (x *TGetTopAuthorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetTopAuthorsResponse.ProtoReflect.Descriptor instead.
+func (*TGetTopAuthorsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TGetTopAuthorsResponse) GetAuthors() []*TGetTopAuthorsResponse_TAuthor {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+type TAddPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      uint64 `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	AuthorLogin string `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+}
+
+func (x *TAddPostRequest) Reset() {
+	*x = TAddPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TAddPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TAddPostRequest) ProtoMessage() {}
+
+func (x *TAddPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TAddPostRequest.ProtoReflect.Descriptor instead.
+func (*TAddPostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TAddPostRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TAddPostRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+type TGetTrendingTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only views and likes within the last WindowSeconds are counted, 0 means default window
+	WindowSeconds uint64 `protobuf:"varint,1,opt,name=WindowSeconds,proto3" json:"WindowSeconds,omitempty"`
+	Limit         uint32 `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *TGetTrendingTagsRequest) Reset() {
+	*x = TGetTrendingTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetTrendingTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetTrendingTagsRequest) ProtoMessage() {}
+
+func (x *TGetTrendingTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetTrendingTagsRequest.ProtoReflect.Descriptor instead.
+func (*TGetTrendingTagsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TGetTrendingTagsRequest) GetWindowSeconds() uint64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *TGetTrendingTagsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TGetTrendingTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*TGetTrendingTagsResponse_TTag `protobuf:"bytes,1,rep,name=Tags,proto3" json:"Tags,omitempty"`
+}
+
+func (x *TGetTrendingTagsResponse) Reset() {
+	*x = TGetTrendingTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetTrendingTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetTrendingTagsResponse) ProtoMessage() {}
+
+func (x *TGetTrendingTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetTrendingTagsResponse.ProtoReflect.Descriptor instead.
+func (*TGetTrendingTagsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TGetTrendingTagsResponse) GetTags() []*TGetTrendingTagsResponse_TTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TGetAuthorAnalyticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorLogin string       `protobuf:"bytes,1,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	Window      *TTimeWindow `protobuf:"bytes,2,opt,name=Window,proto3" json:"Window,omitempty"`
+	// Orders the best posts of the window
+	OrderBy       TGetTopPostsRequest_EOrderBy `protobuf:"varint,3,opt,name=OrderBy,proto3,enum=post.TGetTopPostsRequest_EOrderBy" json:"OrderBy,omitempty"`
+	TopPostsLimit uint32                       `protobuf:"varint,4,opt,name=TopPostsLimit,proto3" json:"TopPostsLimit,omitempty"`
+}
+
+func (x *TGetAuthorAnalyticsRequest) Reset() {
+	*x = TGetAuthorAnalyticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetAuthorAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetAuthorAnalyticsRequest) ProtoMessage() {}
+
+func (x *TGetAuthorAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetAuthorAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*TGetAuthorAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TGetAuthorAnalyticsRequest) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TGetAuthorAnalyticsRequest) GetWindow() *TTimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *TGetAuthorAnalyticsRequest) GetOrderBy() TGetTopPostsRequest_EOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return TGetTopPostsRequest_LIKES
+}
+
+func (x *TGetAuthorAnalyticsRequest) GetTopPostsLimit() uint32 {
+	if x != nil {
+		return x.TopPostsLimit
+	}
+	return 0
+}
+
+type TAuthorTotals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Views uint64 `protobuf:"varint,1,opt,name=Views,proto3" json:"Views,omitempty"`
+	Likes uint64 `protobuf:"varint,2,opt,name=Likes,proto3" json:"Likes,omitempty"`
+	// Distinct users that viewed any of the posts
+	UniqueViewers  uint64  `protobuf:"varint,3,opt,name=UniqueViewers,proto3" json:"UniqueViewers,omitempty"`
+	EngagementRate float64 `protobuf:"fixed64,4,opt,name=EngagementRate,proto3" json:"EngagementRate,omitempty"`
+}
+
+func (x *TAuthorTotals) Reset() {
+	*x = TAuthorTotals{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TAuthorTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TAuthorTotals) ProtoMessage() {}
+
+func (x *TAuthorTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TAuthorTotals.ProtoReflect.Descriptor instead.
+func (*TAuthorTotals) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TAuthorTotals) GetViews() uint64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *TAuthorTotals) GetLikes() uint64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *TAuthorTotals) GetUniqueViewers() uint64 {
+	if x != nil {
+		return x.UniqueViewers
+	}
+	return 0
+}
+
+func (x *TAuthorTotals) GetEngagementRate() float64 {
+	if x != nil {
+		return x.EngagementRate
+	}
+	return 0
+}
+
+type TGetAuthorAnalyticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorLogin string `protobuf:"bytes,1,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	// The resolved window
+	From    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	To      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+	AllTime *TAuthorTotals         `protobuf:"bytes,4,opt,name=AllTime,proto3" json:"AllTime,omitempty"`
+	Window  *TAuthorTotals         `protobuf:"bytes,5,opt,name=Window,proto3" json:"Window,omitempty"`
+	// The window of the same length right before this one
+	PreviousWindow *TAuthorTotals                       `protobuf:"bytes,6,opt,name=PreviousWindow,proto3" json:"PreviousWindow,omitempty"`
+	Change         *TGetAuthorAnalyticsResponse_TChange `protobuf:"bytes,7,opt,name=Change,proto3" json:"Change,omitempty"`
+	TopPosts       []*TGetTopPostsResponse_TPostStat    `protobuf:"bytes,8,rep,name=TopPosts,proto3" json:"TopPosts,omitempty"`
+	// Filled by main_service from post_service
+	Followers *TFollowerGrowth `protobuf:"bytes,9,opt,name=Followers,proto3" json:"Followers,omitempty"`
+}
+
+func (x *TGetAuthorAnalyticsResponse) Reset() {
+	*x = TGetAuthorAnalyticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetAuthorAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetAuthorAnalyticsResponse) ProtoMessage() {}
+
+func (x *TGetAuthorAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetAuthorAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*TGetAuthorAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TGetAuthorAnalyticsResponse) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TGetAuthorAnalyticsResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TGetAuthorAnalyticsResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TGetAuthorAnalyticsResponse) GetAllTime() *TAuthorTotals {
+	if x != nil {
+		return x.AllTime
+	}
+	return nil
+}
+
+func (x *TGetAuthorAnalyticsResponse) GetWindow() *TAuthorTotals {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *TGetAuthorAnalyticsResponse) GetPreviousWindow() *TAuthorTotals {
+	if x != nil {
+		return x.PreviousWindow
+	}
+	return nil
+}
+
+func (x *TGetAuthorAnalyticsResponse) GetChange() *TGetAuthorAnalyticsResponse_TChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *TGetAuthorAnalyticsResponse) GetTopPosts() []*TGetTopPostsResponse_TPostStat {
+	if x != nil {
+		return x.TopPosts
+	}
+	return nil
+}
+
+func (x *TGetAuthorAnalyticsResponse) GetFollowers() *TFollowerGrowth {
+	if x != nil {
+		return x.Followers
+	}
+	return nil
+}
+
+type TPoll_TOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Options are numbered from 1 in the order they were given
+	OptionId uint32 `protobuf:"varint,1,opt,name=OptionId,proto3" json:"OptionId,omitempty"`
+	Text     string `protobuf:"bytes,2,opt,name=Text,proto3" json:"Text,omitempty"`
+}
+
+func (x *TPoll_TOption) Reset() {
+	*x = TPoll_TOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TPoll_TOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TPoll_TOption) ProtoMessage() {}
+
+func (x *TPoll_TOption) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TPoll_TOption.ProtoReflect.Descriptor instead.
+func (*TPoll_TOption) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *TPoll_TOption) GetOptionId() uint32 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *TPoll_TOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type TSearchPostsResponse_THit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *TPost  `protobuf:"bytes,1,opt,name=Post,proto3" json:"Post,omitempty"`
+	Rank float64 `protobuf:"fixed64,2,opt,name=Rank,proto3" json:"Rank,omitempty"`
+	// Matched fragments as HTML, the post text is escaped and matches are wrapped into <b></b>
+	TitleHighlight   string `protobuf:"bytes,3,opt,name=TitleHighlight,proto3" json:"TitleHighlight,omitempty"`
+	ContentHighlight string `protobuf:"bytes,4,opt,name=ContentHighlight,proto3" json:"ContentHighlight,omitempty"`
+}
+
+func (x *TSearchPostsResponse_THit) Reset() {
+	*x = TSearchPostsResponse_THit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSearchPostsResponse_THit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSearchPostsResponse_THit) ProtoMessage() {}
+
+func (x *TSearchPostsResponse_THit) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSearchPostsResponse_THit.ProtoReflect.Descriptor instead.
+func (*TSearchPostsResponse_THit) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *TSearchPostsResponse_THit) GetPost() *TPost {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *TSearchPostsResponse_THit) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TSearchPostsResponse_THit) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *TSearchPostsResponse_THit) GetContentHighlight() string {
+	if x != nil {
+		return x.ContentHighlight
+	}
+	return ""
+}
+
+type TGetPostTimeSeriesResponse_TBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=Start,proto3" json:"Start,omitempty"`
+	Views uint64                 `protobuf:"varint,2,opt,name=Views,proto3" json:"Views,omitempty"`
+	Likes uint64                 `protobuf:"varint,3,opt,name=Likes,proto3" json:"Likes,omitempty"`
+}
+
+func (x *TGetPostTimeSeriesResponse_TBucket) Reset() {
+	*x = TGetPostTimeSeriesResponse_TBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPostTimeSeriesResponse_TBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPostTimeSeriesResponse_TBucket) ProtoMessage() {}
+
+func (x *TGetPostTimeSeriesResponse_TBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPostTimeSeriesResponse_TBucket.ProtoReflect.Descriptor instead.
+func (*TGetPostTimeSeriesResponse_TBucket) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{60, 0}
+}
+
+func (x *TGetPostTimeSeriesResponse_TBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TGetPostTimeSeriesResponse_TBucket) GetViews() uint64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *TGetPostTimeSeriesResponse_TBucket) GetLikes() uint64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+type TGetPollResultsResponse_TOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OptionId uint32 `protobuf:"varint,1,opt,name=OptionId,proto3" json:"OptionId,omitempty"`
+	// Filled in by main_service from the poll of the post
+	Text  string `protobuf:"bytes,2,opt,name=Text,proto3" json:"Text,omitempty"`
+	Votes uint64 `protobuf:"varint,3,opt,name=Votes,proto3" json:"Votes,omitempty"`
+}
+
+func (x *TGetPollResultsResponse_TOption) Reset() {
+	*x = TGetPollResultsResponse_TOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetPollResultsResponse_TOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetPollResultsResponse_TOption) ProtoMessage() {}
+
+func (x *TGetPollResultsResponse_TOption) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetPollResultsResponse_TOption.ProtoReflect.Descriptor instead.
+func (*TGetPollResultsResponse_TOption) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{62, 0}
+}
+
+func (x *TGetPollResultsResponse_TOption) GetOptionId() uint32 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *TGetPollResultsResponse_TOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TGetPollResultsResponse_TOption) GetVotes() uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+type TGetTopPostsResponse_TPostStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId         uint64  `protobuf:"varint,1,opt,name=PostId,proto3" json:"PostId,omitempty"`
+	AuthorLogin    string  `protobuf:"bytes,2,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	Likes          uint64  `protobuf:"varint,3,opt,name=Likes,proto3" json:"Likes,omitempty"`
+	Views          uint64  `protobuf:"varint,4,opt,name=Views,proto3" json:"Views,omitempty"`
+	UniqueViewers  uint64  `protobuf:"varint,5,opt,name=UniqueViewers,proto3" json:"UniqueViewers,omitempty"`
+	EngagementRate float64 `protobuf:"fixed64,6,opt,name=EngagementRate,proto3" json:"EngagementRate,omitempty"`
+}
+
+func (x *TGetTopPostsResponse_TPostStat) Reset() {
+	*x = TGetTopPostsResponse_TPostStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetTopPostsResponse_TPostStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetTopPostsResponse_TPostStat) ProtoMessage() {}
+
+func (x *TGetTopPostsResponse_TPostStat) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetTopPostsResponse_TPostStat.ProtoReflect.Descriptor instead.
+func (*TGetTopPostsResponse_TPostStat) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{67, 0}
+}
+
+func (x *TGetTopPostsResponse_TPostStat) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TGetTopPostsResponse_TPostStat) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TGetTopPostsResponse_TPostStat) GetLikes() uint64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *TGetTopPostsResponse_TPostStat) GetViews() uint64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *TGetTopPostsResponse_TPostStat) GetUniqueViewers() uint64 {
+	if x != nil {
+		return x.UniqueViewers
+	}
+	return 0
+}
+
+func (x *TGetTopPostsResponse_TPostStat) GetEngagementRate() float64 {
+	if x != nil {
+		return x.EngagementRate
+	}
+	return 0
+}
+
+type TGetTopAuthorsResponse_TAuthor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorLogin string `protobuf:"bytes,1,opt,name=AuthorLogin,proto3" json:"AuthorLogin,omitempty"`
+	Likes       uint64 `protobuf:"varint,2,opt,name=Likes,proto3" json:"Likes,omitempty"`
+	Views       uint64 `protobuf:"varint,3,opt,name=Views,proto3" json:"Views,omitempty"`
+	// Distinct users that viewed any of the posts of the author
+	UniqueViewers  uint64  `protobuf:"varint,4,opt,name=UniqueViewers,proto3" json:"UniqueViewers,omitempty"`
+	EngagementRate float64 `protobuf:"fixed64,5,opt,name=EngagementRate,proto3" json:"EngagementRate,omitempty"`
+}
+
+func (x *TGetTopAuthorsResponse_TAuthor) Reset() {
+	*x = TGetTopAuthorsResponse_TAuthor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetTopAuthorsResponse_TAuthor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetTopAuthorsResponse_TAuthor) ProtoMessage() {}
+
+func (x *TGetTopAuthorsResponse_TAuthor) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetTopAuthorsResponse_TAuthor.ProtoReflect.Descriptor instead.
+func (*TGetTopAuthorsResponse_TAuthor) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{69, 0}
+}
+
+func (x *TGetTopAuthorsResponse_TAuthor) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *TGetTopAuthorsResponse_TAuthor) GetLikes() uint64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *TGetTopAuthorsResponse_TAuthor) GetViews() uint64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *TGetTopAuthorsResponse_TAuthor) GetUniqueViewers() uint64 {
+	if x != nil {
+		return x.UniqueViewers
+	}
+	return 0
+}
+
+func (x *TGetTopAuthorsResponse_TAuthor) GetEngagementRate() float64 {
+	if x != nil {
+		return x.EngagementRate
+	}
+	return 0
+}
+
+type TGetTrendingTagsResponse_TTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag   string `protobuf:"bytes,1,opt,name=Tag,proto3" json:"Tag,omitempty"`
+	Views uint64 `protobuf:"varint,2,opt,name=Views,proto3" json:"Views,omitempty"`
+	Likes uint64 `protobuf:"varint,3,opt,name=Likes,proto3" json:"Likes,omitempty"`
+}
+
+func (x *TGetTrendingTagsResponse_TTag) Reset() {
+	*x = TGetTrendingTagsResponse_TTag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetTrendingTagsResponse_TTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetTrendingTagsResponse_TTag) ProtoMessage() {}
+
+func (x *TGetTrendingTagsResponse_TTag) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetTrendingTagsResponse_TTag.ProtoReflect.Descriptor instead.
+func (*TGetTrendingTagsResponse_TTag) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{72, 0}
+}
+
+func (x *TGetTrendingTagsResponse_TTag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TGetTrendingTagsResponse_TTag) GetViews() uint64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *TGetTrendingTagsResponse_TTag) GetLikes() uint64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+// Window minus previous window
+type TGetAuthorAnalyticsResponse_TChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Views          int64   `protobuf:"varint,1,opt,name=Views,proto3" json:"Views,omitempty"`
+	Likes          int64   `protobuf:"varint,2,opt,name=Likes,proto3" json:"Likes,omitempty"`
+	UniqueViewers  int64   `protobuf:"varint,3,opt,name=UniqueViewers,proto3" json:"UniqueViewers,omitempty"`
+	EngagementRate float64 `protobuf:"fixed64,4,opt,name=EngagementRate,proto3" json:"EngagementRate,omitempty"`
+}
+
+func (x *TGetAuthorAnalyticsResponse_TChange) Reset() {
+	*x = TGetAuthorAnalyticsResponse_TChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TGetAuthorAnalyticsResponse_TChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TGetAuthorAnalyticsResponse_TChange) ProtoMessage() {}
+
+func (x *TGetAuthorAnalyticsResponse_TChange) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TGetAuthorAnalyticsResponse_TChange.ProtoReflect.Descriptor instead.
+func (*TGetAuthorAnalyticsResponse_TChange) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{75, 0}
+}
+
+func (x *TGetAuthorAnalyticsResponse_TChange) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *TGetAuthorAnalyticsResponse_TChange) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *TGetAuthorAnalyticsResponse_TChange) GetUniqueViewers() int64 {
+	if x != nil {
+		return x.UniqueViewers
+	}
+	return 0
+}
+
+func (x *TGetAuthorAnalyticsResponse_TChange) GetEngagementRate() float64 {
+	if x != nil {
+		return x.EngagementRate
+	}
+	return 0
+}
+
+var File_post_proto protoreflect.FileDescriptor
+
+var file_post_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x93, 0x06, 0x0a, 0x05, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33,
+	0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74,
+	0x2e, 0x45, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x38, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x45,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x09, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f,
+	0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4c,
+	0x69, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x22, 0x32, 0x0a, 0x07, 0x45, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09,
+	0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44,
+	0x52, 0x41, 0x46, 0x54, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55,
+	0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x43, 0x0a, 0x0b, 0x45, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x55, 0x4e, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a,
+	0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x03, 0x22, 0x2c, 0x0a, 0x05, 0x45, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x22, 0xa9, 0x01, 0x0a, 0x05, 0x54, 0x50, 0x6f,
+	0x6c, 0x6c, 0x12, 0x2d, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x6c, 0x6c,
+	0x2e, 0x54, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x36, 0x0a, 0x08, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x39, 0x0a, 0x07, 0x54, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x54, 0x65, 0x78, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x09, 0x54, 0x50, 0x6f, 0x6c, 0x6c, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07,
+	0x56, 0x6f, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x56, 0x6f, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x63, 0x0a, 0x0e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x04,
+	0x50, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xea, 0x02, 0x0a, 0x0b, 0x54, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x62, 0x4b, 0x65, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x6f, 0x62, 0x4b, 0x65, 0x79, 0x12,
+	0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x57, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x6c, 0x75, 0x72, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x42, 0x6c, 0x75, 0x72, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x08, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x22, 0xce, 0x02, 0x0a, 0x0d, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x2e, 0x45, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x2e, 0x45, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x57, 0x69, 0x64, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x42,
+	0x6c, 0x6f, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c,
+	0x6f, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x30, 0x0a, 0x05, 0x45, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0c,
+	0x0a, 0x08, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x48, 0x55, 0x4d,
+	0x42, 0x4e, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x22, 0x2c, 0x0a, 0x06, 0x45, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x6b, 0x0a, 0x09, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4a,
+	0x6f, 0x62, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x62, 0x4b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x6f, 0x62, 0x4b, 0x65, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x0a, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x69, 0x65,
+	0x77, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x56, 0x69, 0x65, 0x77, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x88, 0x03, 0x0a, 0x12, 0x54, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41,
+	0x74, 0x12, 0x37, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f,
+	0x73, 0x74, 0x2e, 0x45, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x04, 0x4b, 0x69,
+	0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x54, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x52, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x50, 0x6f, 0x6c, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x13,
+	0x54, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb9, 0x02, 0x0a, 0x12,
+	0x54, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x0f,
+	0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x12, 0x37, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50,
+	0x6f, 0x73, 0x74, 0x2e, 0x45, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x54, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x12, 0x54, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x4f, 0x0a, 0x13, 0x54, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x45, 0x0a, 0x14, 0x54, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04,
+	0x50, 0x6f, 0x73, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x50, 0x6f, 0x73, 0x74,
+	0x22, 0x52, 0x0a, 0x16, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x6e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x22, 0x3c, 0x0a, 0x17, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x4f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x54, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x22, 0x36,
+	0x0a, 0x12, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0xd3, 0x01, 0x0a, 0x0d, 0x54, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x55, 0x0a, 0x19,
+	0x54, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x22, 0x4f, 0x0a, 0x1a, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x73, 0x0a, 0x17, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x5d, 0x0a, 0x18, 0x54, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x19, 0x54, 0x44, 0x69,
+	0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x26,
+	0x0a, 0x0e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x54, 0x6f,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x6e, 0x0a, 0x09,
+	0x54, 0x44, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x02, 0x4f, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x44, 0x69,
+	0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x4f, 0x70, 0x52, 0x02, 0x4f, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65,
+	0x78, 0x74, 0x22, 0x28, 0x0a, 0x03, 0x45, 0x4f, 0x70, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x51, 0x55,
+	0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x22, 0x6e, 0x0a, 0x1a,
+	0x54, 0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x54, 0x44, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x29, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x44, 0x69, 0x66, 0x66, 0x4c,
+	0x69, 0x6e, 0x65, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x1b,
+	0x54, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x9f, 0x01, 0x0a, 0x0c, 0x54, 0x54, 0x72, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x34, 0x0a, 0x07, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x74, 0x22, 0x35, 0x0a, 0x11, 0x54, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x3e,
+	0x0a, 0x12, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x4f,
+	0x0a, 0x13, 0x54, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22,
+	0xff, 0x02, 0x0a, 0x0a, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x52, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x05,
+	0x45, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x54, 0x41, 0x47, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x16, 0x0a, 0x12, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x50, 0x4f,
+	0x53, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x44,
+	0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10,
+	0x07, 0x22, 0xf6, 0x02, 0x0a, 0x08, 0x54, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x4c, 0x69, 0x6b,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x54,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x22, 0x71, 0x0a, 0x15, 0x54, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x10, 0x54, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x54, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x15, 0x54, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22,
+	0xfe, 0x01, 0x0a, 0x14, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x05,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x29, 0x0a, 0x06, 0x45, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x0a, 0x0a, 0x06, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4f,
+	0x4c, 0x44, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4f, 0x50, 0x10, 0x02,
+	0x22, 0x63, 0x0a, 0x15, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x54, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x13, 0x54, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x22, 0x63, 0x0a, 0x15, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x54,
+	0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x61, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x50,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0xff, 0x01, 0x0a, 0x13, 0x54, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x3c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x50, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0xdd, 0x01, 0x0a, 0x14, 0x54, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x33, 0x0a, 0x04, 0x48, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x48, 0x69, 0x74, 0x52,
+	0x04, 0x48, 0x69, 0x74, 0x73, 0x1a, 0x8f, 0x01, 0x0a, 0x04, 0x54, 0x48, 0x69, 0x74, 0x12, 0x1f,
+	0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x52,
+	0x61, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x48, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x69,
+	0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x15, 0x54, 0x41, 0x64, 0x64,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x46,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x42, 0x6c, 0x6f, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x42, 0x6c, 0x6f, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x5d, 0x0a, 0x15, 0x54, 0x47, 0x65, 0x74, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x48, 0x0a, 0x0e, 0x54, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65,
+	0x22, 0x2d, 0x0a, 0x15, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22,
+	0x30, 0x0a, 0x16, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x73, 0x22, 0x8d, 0x01, 0x0a, 0x19, 0x54, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x54,
+	0x6f, 0x22, 0x73, 0x0a, 0x0f, 0x54, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x77, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x47, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x47, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79,
+	0x47, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x22, 0xb5, 0x02, 0x0a, 0x0d, 0x54, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x2d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x50, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x52, 0x65, 0x66, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x3d, 0x0a, 0x05, 0x45, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x0d, 0x0a, 0x09, 0x4d, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x22, 0x44,
+	0x0a, 0x14, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x50, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x19, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22,
+	0x57, 0x0a, 0x1a, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x60, 0x0a, 0x18, 0x54, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x5c, 0x0a, 0x13, 0x54, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x14, 0x54, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x15, 0x54, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69,
+	0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0xfa,
+	0x01, 0x0a, 0x19, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x54, 0x6f,
+	0x12, 0x3f, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x27, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x22, 0x28, 0x0a, 0x07, 0x45, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x08, 0x0a, 0x04,
+	0x48, 0x4f, 0x55, 0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45,
+	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x41, 0x59, 0x10, 0x02, 0x22, 0xe1, 0x01, 0x0a, 0x1a,
+	0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x42, 0x0a, 0x07, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x1a, 0x67, 0x0a, 0x07, 0x54, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6b,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x22,
+	0x52, 0x0a, 0x16, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x22, 0xb3, 0x02, 0x0a, 0x17, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x54, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x1a, 0x4f, 0x0a, 0x07, 0x54, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54,
+	0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x16, 0x54, 0x47, 0x65,
+	0x74, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22,
+	0x3d, 0x0a, 0x17, 0x54, 0x47, 0x65, 0x74, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6b,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4c, 0x69,
+	0x6b, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0xbd,
+	0x02, 0x0a, 0x13, 0x54, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x45, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x07, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x49, 0x0a,
+	0x08, 0x45, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4b,
+	0x45, 0x53, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x49, 0x45, 0x57, 0x53, 0x10, 0x01, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x45, 0x52,
+	0x53, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x4e, 0x47, 0x41, 0x47, 0x45, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0xe1,
+	0x01, 0x0a, 0x0b, 0x54, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x31,
+	0x0a, 0x06, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x2e, 0x45, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x06, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x12, 0x2e, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x54, 0x6f, 0x22, 0x43, 0x0a,
+	0x07, 0x45, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4c, 0x4c, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x48,
+	0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x41,
+	0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x57, 0x45, 0x45, 0x4b,
+	0x10, 0x03, 0x22, 0x94, 0x02, 0x0a, 0x14, 0x54, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x52, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x1a, 0xbf, 0x01, 0x0a, 0x09, 0x54, 0x50, 0x6f, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x45, 0x6e, 0x67, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x15, 0x54, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x45, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54,
+	0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x50, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x16, 0x54,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x07, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x73, 0x1a, 0xa5, 0x01, 0x0a, 0x07, 0x54, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x65,
+	0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x45,
+	0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x4b, 0x0a,
+	0x0f, 0x54, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x55, 0x0a, 0x17, 0x54, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x99, 0x01, 0x0a, 0x18, 0x54, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x54, 0x61,
+	0x67, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x44, 0x0a, 0x04, 0x54, 0x54, 0x61, 0x67, 0x12,
+	0x10, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x54, 0x61,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0xcd, 0x01,
+	0x0a, 0x1a, 0x54, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x29,
+	0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x52, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3c, 0x0a, 0x07, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x07,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x89, 0x01,
+	0x0a, 0x0d, 0x54, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x56, 0x69, 0x65, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x45, 0x6e, 0x67, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0xf4, 0x04, 0x0a, 0x1b, 0x54, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x46,
+	0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x2d, 0x0a, 0x07, 0x41, 0x6c, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x54, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x07, 0x41,
+	0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x06, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x3b, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x54, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73,
+	0x52, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x41, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x54, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x54, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x08, 0x54, 0x6f, 0x70,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x54, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52,
+	0x09, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x1a, 0x83, 0x01, 0x0a, 0x07, 0x54,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6b,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x6e, 0x67, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65,
+	0x32, 0xd2, 0x11, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x43, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x54, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x54, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x4f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x54, 0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x54, 0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a,
+	0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x17, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x54, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54,
+	0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x12, 0x1b, 0x2e,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x6e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x41, 0x64, 0x64,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x54, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x54, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73,
+	0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x6e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x06, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x08, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x12, 0x14, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x77, 0x74, 0x68, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x4c, 0x69, 0x73, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x54, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xd8, 0x05, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x58, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47,
+	0x65, 0x74, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x54, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x54, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x1d, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x3b, 0x70, 0x6f, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_post_proto_rawDescOnce sync.Once
+	file_post_proto_rawDescData = file_post_proto_rawDesc
+)
+
+func file_post_proto_rawDescGZIP() []byte {
+	file_post_proto_rawDescOnce.Do(func() {
+		file_post_proto_rawDescData = protoimpl.X.CompressGZIP(file_post_proto_rawDescData)
+	})
+	return file_post_proto_rawDescData
+}
+
+var file_post_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
+var file_post_proto_goTypes = []interface{}{
+	(TPost_EStatus)(0),                          // 0: post.TPost.EStatus
+	(TPost_EVisibility)(0),                      // 1: post.TPost.EVisibility
+	(TPost_EKind)(0),                            // 2: post.TPost.EKind
+	(TImageVariant_EName)(0),                    // 3: post.TImageVariant.EName
+	(TImageVariant_EState)(0),                   // 4: post.TImageVariant.EState
+	(TDiffLine_EOp)(0),                          // 5: post.TDiffLine.EOp
+	(TPostEvent_EType)(0),                       // 6: post.TPostEvent.EType
+	(TListCommentsRequest_EOrder)(0),            // 7: post.TListCommentsRequest.EOrder
+	(TNotification_EType)(0),                    // 8: post.TNotification.EType
+	(TGetPostTimeSeriesRequest_EBucket)(0),      // 9: post.TGetPostTimeSeriesRequest.EBucket
+	(TGetTopPostsRequest_EOrderBy)(0),           // 10: post.TGetTopPostsRequest.EOrderBy
+	(TTimeWindow_EPreset)(0),                    // 11: post.TTimeWindow.EPreset
+	(*TPost)(nil),                               // 12: post.TPost
+	(*TPoll)(nil),                               // 13: post.TPoll
+	(*TPollVote)(nil),                           // 14: post.TPollVote
+	(*TPostReference)(nil),                      // 15: post.TPostReference
+	(*TAttachment)(nil),                         // 16: post.TAttachment
+	(*TImageVariant)(nil),                       // 17: post.TImageVariant
+	(*TImageJob)(nil),                           // 18: post.TImageJob
+	(*TPostStats)(nil),                          // 19: post.TPostStats
+	(*TCreatePostRequest)(nil),                  // 20: post.TCreatePostRequest
+	(*TCreatePostResponse)(nil),                 // 21: post.TCreatePostResponse
+	(*TUpdatePostRequest)(nil),                  // 22: post.TUpdatePostRequest
+	(*TUpdatePostResponse)(nil),                 // 23: post.TUpdatePostResponse
+	(*TDeletePostRequest)(nil),                  // 24: post.TDeletePostRequest
+	(*TGetPostByIdRequest)(nil),                 // 25: post.TGetPostByIdRequest
+	(*TGetPostByIdResponse)(nil),                // 26: post.TGetPostByIdResponse
+	(*TGetPostsOnPageRequest)(nil),              // 27: post.TGetPostsOnPageRequest
+	(*TGetPostsOnPageResponse)(nil),             // 28: post.TGetPostsOnPageResponse
+	(*TPublishPostRequest)(nil),                 // 29: post.TPublishPostRequest
+	(*TListDraftsRequest)(nil),                  // 30: post.TListDraftsRequest
+	(*TPostRevision)(nil),                       // 31: post.TPostRevision
+	(*TListPostRevisionsRequest)(nil),           // 32: post.TListPostRevisionsRequest
+	(*TListPostRevisionsResponse)(nil),          // 33: post.TListPostRevisionsResponse
+	(*TGetPostRevisionRequest)(nil),             // 34: post.TGetPostRevisionRequest
+	(*TGetPostRevisionResponse)(nil),            // 35: post.TGetPostRevisionResponse
+	(*TDiffPostRevisionsRequest)(nil),           // 36: post.TDiffPostRevisionsRequest
+	(*TDiffLine)(nil),                           // 37: post.TDiffLine
+	(*TDiffPostRevisionsResponse)(nil),          // 38: post.TDiffPostRevisionsResponse
+	(*TRestorePostRevisionRequest)(nil),         // 39: post.TRestorePostRevisionRequest
+	(*TTrashedPost)(nil),                        // 40: post.TTrashedPost
+	(*TListTrashRequest)(nil),                   // 41: post.TListTrashRequest
+	(*TListTrashResponse)(nil),                  // 42: post.TListTrashResponse
+	(*TRestorePostRequest)(nil),                 // 43: post.TRestorePostRequest
+	(*TPostEvent)(nil),                          // 44: post.TPostEvent
+	(*TComment)(nil),                            // 45: post.TComment
+	(*TCreateCommentRequest)(nil),               // 46: post.TCreateCommentRequest
+	(*TUpdateCommentRequest)(nil),               // 47: post.TUpdateCommentRequest
+	(*TCommentResponse)(nil),                    // 48: post.TCommentResponse
+	(*TDeleteCommentRequest)(nil),               // 49: post.TDeleteCommentRequest
+	(*TListCommentsRequest)(nil),                // 50: post.TListCommentsRequest
+	(*TListCommentsResponse)(nil),               // 51: post.TListCommentsResponse
+	(*TLikeCommentRequest)(nil),                 // 52: post.TLikeCommentRequest
+	(*TGetPostsByTagRequest)(nil),               // 53: post.TGetPostsByTagRequest
+	(*TSearchPostsRequest)(nil),                 // 54: post.TSearchPostsRequest
+	(*TSearchPostsResponse)(nil),                // 55: post.TSearchPostsResponse
+	(*TAddAttachmentRequest)(nil),               // 56: post.TAddAttachmentRequest
+	(*TGetAttachmentRequest)(nil),               // 57: post.TGetAttachmentRequest
+	(*TFollowRequest)(nil),                      // 58: post.TFollowRequest
+	(*TListFollowingRequest)(nil),               // 59: post.TListFollowingRequest
+	(*TListFollowingResponse)(nil),              // 60: post.TListFollowingResponse
+	(*TGetFollowerGrowthRequest)(nil),           // 61: post.TGetFollowerGrowthRequest
+	(*TFollowerGrowth)(nil),                     // 62: post.TFollowerGrowth
+	(*TNotification)(nil),                       // 63: post.TNotification
+	(*TListMentionsRequest)(nil),                // 64: post.TListMentionsRequest
+	(*TListNotificationsRequest)(nil),           // 65: post.TListNotificationsRequest
+	(*TListNotificationsResponse)(nil),          // 66: post.TListNotificationsResponse
+	(*TDeleteAttachmentRequest)(nil),            // 67: post.TDeleteAttachmentRequest
+	(*TAttachmentResponse)(nil),                 // 68: post.TAttachmentResponse
+	(*TGetPostStatsRequest)(nil),                // 69: post.TGetPostStatsRequest
+	(*TGetPostStatsResponse)(nil),               // 70: post.TGetPostStatsResponse
+	(*TGetPostTimeSeriesRequest)(nil),           // 71: post.TGetPostTimeSeriesRequest
+	(*TGetPostTimeSeriesResponse)(nil),          // 72: post.TGetPostTimeSeriesResponse
+	(*TGetPollResultsRequest)(nil),              // 73: post.TGetPollResultsRequest
+	(*TGetPollResultsResponse)(nil),             // 74: post.TGetPollResultsResponse
+	(*TGetViewerLikesRequest)(nil),              // 75: post.TGetViewerLikesRequest
+	(*TGetViewerLikesResponse)(nil),             // 76: post.TGetViewerLikesResponse
+	(*TGetTopPostsRequest)(nil),                 // 77: post.TGetTopPostsRequest
+	(*TTimeWindow)(nil),                         // 78: post.TTimeWindow
+	(*TGetTopPostsResponse)(nil),                // 79: post.TGetTopPostsResponse
+	(*TGetTopAuthorsRequest)(nil),               // 80: post.TGetTopAuthorsRequest
+	(*TGetTopAuthorsResponse)(nil),              // 81: post.TGetTopAuthorsResponse
+	(*TAddPostRequest)(nil),                     // 82: post.TAddPostRequest
+	(*TGetTrendingTagsRequest)(nil),             // 83: post.TGetTrendingTagsRequest
+	(*TGetTrendingTagsResponse)(nil),            // 84: post.TGetTrendingTagsResponse
+	(*TGetAuthorAnalyticsRequest)(nil),          // 85: post.TGetAuthorAnalyticsRequest
+	(*TAuthorTotals)(nil),                       // 86: post.TAuthorTotals
+	(*TGetAuthorAnalyticsResponse)(nil),         // 87: post.TGetAuthorAnalyticsResponse
+	(*TPoll_TOption)(nil),                       // 88: post.TPoll.TOption
+	(*TSearchPostsResponse_THit)(nil),           // 89: post.TSearchPostsResponse.THit
+	(*TGetPostTimeSeriesResponse_TBucket)(nil),  // 90: post.TGetPostTimeSeriesResponse.TBucket
+	(*TGetPollResultsResponse_TOption)(nil),     // 91: post.TGetPollResultsResponse.TOption
+	(*TGetTopPostsResponse_TPostStat)(nil),      // 92: post.TGetTopPostsResponse.TPostStat
+	(*TGetTopAuthorsResponse_TAuthor)(nil),      // 93: post.TGetTopAuthorsResponse.TAuthor
+	(*TGetTrendingTagsResponse_TTag)(nil),       // 94: post.TGetTrendingTagsResponse.TTag
+	(*TGetAuthorAnalyticsResponse_TChange)(nil), // 95: post.TGetAuthorAnalyticsResponse.TChange
+	(*timestamppb.Timestamp)(nil),               // 96: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),               // 97: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                       // 98: google.protobuf.Empty
+}
+var file_post_proto_depIdxs = []int32{
+	96,  // 0: post.TPost.CreatedAt:type_name -> google.protobuf.Timestamp
+	16,  // 1: post.TPost.Attachments:type_name -> post.TAttachment
+	0,   // 2: post.TPost.Status:type_name -> post.TPost.EStatus
+	96,  // 3: post.TPost.PublishAt:type_name -> google.protobuf.Timestamp
+	1,   // 4: post.TPost.Visibility:type_name -> post.TPost.EVisibility
+	2,   // 5: post.TPost.Kind:type_name -> post.TPost.EKind
+	15,  // 6: post.TPost.Reference:type_name -> post.TPostReference
+	13,  // 7: post.TPost.Poll:type_name -> post.TPoll
+	88,  // 8: post.TPoll.Options:type_name -> post.TPoll.TOption
+	96,  // 9: post.TPoll.ClosesAt:type_name -> google.protobuf.Timestamp
+	96,  // 10: post.TPollVote.VotedAt:type_name -> google.protobuf.Timestamp
+	12,  // 11: post.TPostReference.Post:type_name -> post.TPost
+	96,  // 12: post.TAttachment.CreatedAt:type_name -> google.protobuf.Timestamp
+	17,  // 13: post.TAttachment.Variants:type_name -> post.TImageVariant
+	3,   // 14: post.TImageVariant.Name:type_name -> post.TImageVariant.EName
+	4,   // 15: post.TImageVariant.State:type_name -> post.TImageVariant.EState
+	96,  // 16: post.TPostStats.Timestamp:type_name -> google.protobuf.Timestamp
+	0,   // 17: post.TCreatePostRequest.Status:type_name -> post.TPost.EStatus
+	96,  // 18: post.TCreatePostRequest.PublishAt:type_name -> google.protobuf.Timestamp
+	1,   // 19: post.TCreatePostRequest.Visibility:type_name -> post.TPost.EVisibility
+	2,   // 20: post.TCreatePostRequest.Kind:type_name -> post.TPost.EKind
+	13,  // 21: post.TCreatePostRequest.Poll:type_name -> post.TPoll
+	97,  // 22: post.TUpdatePostRequest.UpdateMask:type_name -> google.protobuf.FieldMask
+	1,   // 23: post.TUpdatePostRequest.Visibility:type_name -> post.TPost.EVisibility
+	12,  // 24: post.TGetPostByIdResponse.Post:type_name -> post.TPost
+	12,  // 25: post.TGetPostsOnPageResponse.Posts:type_name -> post.TPost
+	96,  // 26: post.TPublishPostRequest.PublishAt:type_name -> google.protobuf.Timestamp
+	96,  // 27: post.TPostRevision.CreatedAt:type_name -> google.protobuf.Timestamp
+	31,  // 28: post.TListPostRevisionsResponse.Revisions:type_name -> post.TPostRevision
+	31,  // 29: post.TGetPostRevisionResponse.Revision:type_name -> post.TPostRevision
+	5,   // 30: post.TDiffLine.Op:type_name -> post.TDiffLine.EOp
+	37,  // 31: post.TDiffPostRevisionsResponse.Title:type_name -> post.TDiffLine
+	37,  // 32: post.TDiffPostRevisionsResponse.Content:type_name -> post.TDiffLine
+	12,  // 33: post.TTrashedPost.Post:type_name -> post.TPost
+	96,  // 34: post.TTrashedPost.DeletedAt:type_name -> google.protobuf.Timestamp
+	96,  // 35: post.TTrashedPost.PurgeAt:type_name -> google.protobuf.Timestamp
+	40,  // 36: post.TListTrashResponse.Posts:type_name -> post.TTrashedPost
+	6,   // 37: post.TPostEvent.Type:type_name -> post.TPostEvent.EType
+	1,   // 38: post.TPostEvent.Visibility:type_name -> post.TPost.EVisibility
+	96,  // 39: post.TComment.CreatedAt:type_name -> google.protobuf.Timestamp
+	96,  // 40: post.TComment.UpdatedAt:type_name -> google.protobuf.Timestamp
+	45,  // 41: post.TCommentResponse.Comment:type_name -> post.TComment
+	7,   // 42: post.TListCommentsRequest.Order:type_name -> post.TListCommentsRequest.EOrder
+	45,  // 43: post.TListCommentsResponse.Comments:type_name -> post.TComment
+	96,  // 44: post.TSearchPostsRequest.CreatedFrom:type_name -> google.protobuf.Timestamp
+	96,  // 45: post.TSearchPostsRequest.CreatedTo:type_name -> google.protobuf.Timestamp
+	89,  // 46: post.TSearchPostsResponse.Hits:type_name -> post.TSearchPostsResponse.THit
+	96,  // 47: post.TGetFollowerGrowthRequest.From:type_name -> google.protobuf.Timestamp
+	96,  // 48: post.TGetFollowerGrowthRequest.To:type_name -> google.protobuf.Timestamp
+	8,   // 49: post.TNotification.Type:type_name -> post.TNotification.EType
+	96,  // 50: post.TNotification.CreatedAt:type_name -> google.protobuf.Timestamp
+	63,  // 51: post.TListNotificationsResponse.Notifications:type_name -> post.TNotification
+	16,  // 52: post.TAttachmentResponse.Attachment:type_name -> post.TAttachment
+	96,  // 53: post.TGetPostTimeSeriesRequest.From:type_name -> google.protobuf.Timestamp
+	96,  // 54: post.TGetPostTimeSeriesRequest.To:type_name -> google.protobuf.Timestamp
+	9,   // 55: post.TGetPostTimeSeriesRequest.Bucket:type_name -> post.TGetPostTimeSeriesRequest.EBucket
+	90,  // 56: post.TGetPostTimeSeriesResponse.Buckets:type_name -> post.TGetPostTimeSeriesResponse.TBucket
+	91,  // 57: post.TGetPollResultsResponse.Options:type_name -> post.TGetPollResultsResponse.TOption
+	10,  // 58: post.TGetTopPostsRequest.OrderBy:type_name -> post.TGetTopPostsRequest.EOrderBy
+	78,  // 59: post.TGetTopPostsRequest.Window:type_name -> post.TTimeWindow
+	11,  // 60: post.TTimeWindow.Preset:type_name -> post.TTimeWindow.EPreset
+	96,  // 61: post.TTimeWindow.From:type_name -> google.protobuf.Timestamp
+	96,  // 62: post.TTimeWindow.To:type_name -> google.protobuf.Timestamp
+	92,  // 63: post.TGetTopPostsResponse.Posts:type_name -> post.TGetTopPostsResponse.TPostStat
+	10,  // 64: post.TGetTopAuthorsRequest.OrderBy:type_name -> post.TGetTopPostsRequest.EOrderBy
+	78,  // 65: post.TGetTopAuthorsRequest.Window:type_name -> post.TTimeWindow
+	93,  // 66: post.TGetTopAuthorsResponse.Authors:type_name -> post.TGetTopAuthorsResponse.TAuthor
+	94,  // 67: post.TGetTrendingTagsResponse.Tags:type_name -> post.TGetTrendingTagsResponse.TTag
+	78,  // 68: post.TGetAuthorAnalyticsRequest.Window:type_name -> post.TTimeWindow
+	10,  // 69: post.TGetAuthorAnalyticsRequest.OrderBy:type_name -> post.TGetTopPostsRequest.EOrderBy
+	96,  // 70: post.TGetAuthorAnalyticsResponse.From:type_name -> google.protobuf.Timestamp
+	96,  // 71: post.TGetAuthorAnalyticsResponse.To:type_name -> google.protobuf.Timestamp
+	86,  // 72: post.TGetAuthorAnalyticsResponse.AllTime:type_name -> post.TAuthorTotals
+	86,  // 73: post.TGetAuthorAnalyticsResponse.Window:type_name -> post.TAuthorTotals
+	86,  // 74: post.TGetAuthorAnalyticsResponse.PreviousWindow:type_name -> post.TAuthorTotals
+	95,  // 75: post.TGetAuthorAnalyticsResponse.Change:type_name -> post.TGetAuthorAnalyticsResponse.TChange
+	92,  // 76: post.TGetAuthorAnalyticsResponse.TopPosts:type_name -> post.TGetTopPostsResponse.TPostStat
+	62,  // 77: post.TGetAuthorAnalyticsResponse.Followers:type_name -> post.TFollowerGrowth
+	12,  // 78: post.TSearchPostsResponse.THit.Post:type_name -> post.TPost
+	96,  // 79: post.TGetPostTimeSeriesResponse.TBucket.Start:type_name -> google.protobuf.Timestamp
+	20,  // 80: post.PostService.CreatePost:input_type -> post.TCreatePostRequest
+	22,  // 81: post.PostService.UpdatePost:input_type -> post.TUpdatePostRequest
+	24,  // 82: post.PostService.DeletePost:input_type -> post.TDeletePostRequest
+	25,  // 83: post.PostService.GetPostById:input_type -> post.TGetPostByIdRequest
+	27,  // 84: post.PostService.GetPostsOnPage:input_type -> post.TGetPostsOnPageRequest
+	32,  // 85: post.PostService.ListPostRevisions:input_type -> post.TListPostRevisionsRequest
+	34,  // 86: post.PostService.GetPostRevision:input_type -> post.TGetPostRevisionRequest
+	36,  // 87: post.PostService.DiffPostRevisions:input_type -> post.TDiffPostRevisionsRequest
+	39,  // 88: post.PostService.RestorePostRevision:input_type -> post.TRestorePostRevisionRequest
+	41,  // 89: post.PostService.ListTrash:input_type -> post.TListTrashRequest
+	43,  // 90: post.PostService.RestorePost:input_type -> post.TRestorePostRequest
+	46,  // 91: post.PostService.CreateComment:input_type -> post.TCreateCommentRequest
+	47,  // 92: post.PostService.UpdateComment:input_type -> post.TUpdateCommentRequest
+	49,  // 93: post.PostService.DeleteComment:input_type -> post.TDeleteCommentRequest
+	50,  // 94: post.PostService.ListComments:input_type -> post.TListCommentsRequest
+	52,  // 95: post.PostService.LikeComment:input_type -> post.TLikeCommentRequest
+	52,  // 96: post.PostService.UnlikeComment:input_type -> post.TLikeCommentRequest
+	53,  // 97: post.PostService.GetPostsByTag:input_type -> post.TGetPostsByTagRequest
+	54,  // 98: post.PostService.SearchPosts:input_type -> post.TSearchPostsRequest
+	56,  // 99: post.PostService.AddAttachment:input_type -> post.TAddAttachmentRequest
+	57,  // 100: post.PostService.GetAttachment:input_type -> post.TGetAttachmentRequest
+	67,  // 101: post.PostService.DeleteAttachment:input_type -> post.TDeleteAttachmentRequest
+	29,  // 102: post.PostService.PublishPost:input_type -> post.TPublishPostRequest
+	30,  // 103: post.PostService.ListDrafts:input_type -> post.TListDraftsRequest
+	58,  // 104: post.PostService.Follow:input_type -> post.TFollowRequest
+	58,  // 105: post.PostService.Unfollow:input_type -> post.TFollowRequest
+	59,  // 106: post.PostService.ListFollowing:input_type -> post.TListFollowingRequest
+	61,  // 107: post.PostService.GetFollowerGrowth:input_type -> post.TGetFollowerGrowthRequest
+	65,  // 108: post.PostService.ListNotifications:input_type -> post.TListNotificationsRequest
+	64,  // 109: post.PostService.ListMentions:input_type -> post.TListMentionsRequest
+	69,  // 110: post.StatsService.GetPostStats:input_type -> post.TGetPostStatsRequest
+	71,  // 111: post.StatsService.GetPostTimeSeries:input_type -> post.TGetPostTimeSeriesRequest
+	77,  // 112: post.StatsService.GetTopPosts:input_type -> post.TGetTopPostsRequest
+	73,  // 113: post.StatsService.GetPollResults:input_type -> post.TGetPollResultsRequest
+	75,  // 114: post.StatsService.GetViewerLikes:input_type -> post.TGetViewerLikesRequest
+	80,  // 115: post.StatsService.GetTopAuthors:input_type -> post.TGetTopAuthorsRequest
+	85,  // 116: post.StatsService.GetAuthorAnalytics:input_type -> post.TGetAuthorAnalyticsRequest
+	82,  // 117: post.StatsService.AddPost:input_type -> post.TAddPostRequest
+	83,  // 118: post.StatsService.GetTrendingTags:input_type -> post.TGetTrendingTagsRequest
+	21,  // 119: post.PostService.CreatePost:output_type -> post.TCreatePostResponse
+	23,  // 120: post.PostService.UpdatePost:output_type -> post.TUpdatePostResponse
+	98,  // 121: post.PostService.DeletePost:output_type -> google.protobuf.Empty
+	26,  // 122: post.PostService.GetPostById:output_type -> post.TGetPostByIdResponse
+	28,  // 123: post.PostService.GetPostsOnPage:output_type -> post.TGetPostsOnPageResponse
+	33,  // 124: post.PostService.ListPostRevisions:output_type -> post.TListPostRevisionsResponse
+	35,  // 125: post.PostService.GetPostRevision:output_type -> post.TGetPostRevisionResponse
+	38,  // 126: post.PostService.DiffPostRevisions:output_type -> post.TDiffPostRevisionsResponse
+	35,  // 127: post.PostService.RestorePostRevision:output_type -> post.TGetPostRevisionResponse
+	42,  // 128: post.PostService.ListTrash:output_type -> post.TListTrashResponse
+	98,  // 129: post.PostService.RestorePost:output_type -> google.protobuf.Empty
+	48,  // 130: post.PostService.CreateComment:output_type -> post.TCommentResponse
+	48,  // 131: post.PostService.UpdateComment:output_type -> post.TCommentResponse
+	98,  // 132: post.PostService.DeleteComment:output_type -> google.protobuf.Empty
+	51,  // 133: post.PostService.ListComments:output_type -> post.TListCommentsResponse
+	98,  // 134: post.PostService.LikeComment:output_type -> google.protobuf.Empty
+	98,  // 135: post.PostService.UnlikeComment:output_type -> google.protobuf.Empty
+	28,  // 136: post.PostService.GetPostsByTag:output_type -> post.TGetPostsOnPageResponse
+	55,  // 137: post.PostService.SearchPosts:output_type -> post.TSearchPostsResponse
+	68,  // 138: post.PostService.AddAttachment:output_type -> post.TAttachmentResponse
+	68,  // 139: post.PostService.GetAttachment:output_type -> post.TAttachmentResponse
+	68,  // 140: post.PostService.DeleteAttachment:output_type -> post.TAttachmentResponse
+	26,  // 141: post.PostService.PublishPost:output_type -> post.TGetPostByIdResponse
+	28,  // 142: post.PostService.ListDrafts:output_type -> post.TGetPostsOnPageResponse
+	98,  // 143: post.PostService.Follow:output_type -> google.protobuf.Empty
+	98,  // 144: post.PostService.Unfollow:output_type -> google.protobuf.Empty
+	60,  // 145: post.PostService.ListFollowing:output_type -> post.TListFollowingResponse
+	62,  // 146: post.PostService.GetFollowerGrowth:output_type -> post.TFollowerGrowth
+	66,  // 147: post.PostService.ListNotifications:output_type -> post.TListNotificationsResponse
+	28,  // 148: post.PostService.ListMentions:output_type -> post.TGetPostsOnPageResponse
+	70,  // 149: post.StatsService.GetPostStats:output_type -> post.TGetPostStatsResponse
+	72,  // 150: post.StatsService.GetPostTimeSeries:output_type -> post.TGetPostTimeSeriesResponse
+	79,  // 151: post.StatsService.GetTopPosts:output_type -> post.TGetTopPostsResponse
+	74,  // 152: post.StatsService.GetPollResults:output_type -> post.TGetPollResultsResponse
+	76,  // 153: post.StatsService.GetViewerLikes:output_type -> post.TGetViewerLikesResponse
+	81,  // 154: post.StatsService.GetTopAuthors:output_type -> post.TGetTopAuthorsResponse
+	87,  // 155: post.StatsService.GetAuthorAnalytics:output_type -> post.TGetAuthorAnalyticsResponse
+	98,  // 156: post.StatsService.AddPost:output_type -> google.protobuf.Empty
+	84,  // 157: post.StatsService.GetTrendingTags:output_type -> post.TGetTrendingTagsResponse
+	119, // [119:158] is the sub-list for method output_type
+	80,  // [80:119] is the sub-list for method input_type
+	80,  // [80:80] is the sub-list for extension type_name
+	80,  // [80:80] is the sub-list for extension extendee
+	0,   // [0:80] is the sub-list for field type_name
+}
+
+func init() { file_post_proto_init() }
+func file_post_proto_init() {
+	if File_post_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_post_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TPost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TPoll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TPollVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TPostReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TAttachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TImageVariant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TImageJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TPostStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TCreatePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TCreatePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TUpdatePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TUpdatePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TDeletePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPostByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPostByIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPostsOnPageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPostsOnPageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TPublishPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListDraftsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TPostRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListPostRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListPostRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPostRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPostRevisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TDiffPostRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TDiffLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TDiffPostRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TRestorePostRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTrashedPost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListTrashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListTrashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TRestorePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TPostEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TComment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TCreateCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TUpdateCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TDeleteCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListCommentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListCommentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLikeCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPostsByTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSearchPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSearchPostsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1234,8 +8064,8 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TPostStats); i {
+		file_post_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TAddAttachmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1246,8 +8076,8 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TCreatePostRequest); i {
+		file_post_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetAttachmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1258,8 +8088,8 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TCreatePostResponse); i {
+		file_post_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TFollowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1270,8 +8100,8 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TUpdatePostRequest); i {
+		file_post_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListFollowingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1282,8 +8112,8 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TDeletePostRequest); i {
+		file_post_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListFollowingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1294,8 +8124,8 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TGetPostByIdRequest); i {
+		file_post_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetFollowerGrowthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1306,8 +8136,8 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TGetPostByIdResponse); i {
+		file_post_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TFollowerGrowth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1318,8 +8148,8 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TGetPostsOnPageRequest); i {
+		file_post_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1330,8 +8160,8 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TGetPostsOnPageResponse); i {
+		file_post_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListMentionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1342,7 +8172,55 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_post_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListNotificationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TListNotificationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TDeleteAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TGetPostStatsRequest); i {
 			case 0:
 				return &v.state
@@ -1354,7 +8232,7 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_post_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TGetPostStatsResponse); i {
 			case 0:
 				return &v.state
@@ -1366,7 +8244,79 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_post_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPostTimeSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPostTimeSeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPollResultsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPollResultsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetViewerLikesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetViewerLikesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TGetTopPostsRequest); i {
 			case 0:
 				return &v.state
@@ -1378,7 +8328,19 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_post_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTimeWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TGetTopPostsResponse); i {
 			case 0:
 				return &v.state
@@ -1390,7 +8352,19 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_post_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetTopAuthorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TGetTopAuthorsResponse); i {
 			case 0:
 				return &v.state
@@ -1402,7 +8376,7 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_post_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TAddPostRequest); i {
 			case 0:
 				return &v.state
@@ -1414,7 +8388,115 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_post_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetTrendingTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetTrendingTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetAuthorAnalyticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TAuthorTotals); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetAuthorAnalyticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TPoll_TOption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSearchPostsResponse_THit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPostTimeSeriesResponse_TBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetPollResultsResponse_TOption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TGetTopPostsResponse_TPostStat); i {
 			case 0:
 				return &v.state
@@ -1426,7 +8508,7 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
-		file_post_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_post_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TGetTopAuthorsResponse_TAuthor); i {
 			case 0:
 				return &v.state
@@ -1438,21 +8520,49 @@ func file_post_proto_init() {
 				return nil
 			}
 		}
+		file_post_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetTrendingTagsResponse_TTag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TGetAuthorAnalyticsResponse_TChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_post_proto_msgTypes[3].OneofWrappers = []interface{}{}
-	file_post_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_post_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_post_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_post_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_post_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_post_proto_msgTypes[56].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_post_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      12,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
 		GoTypes:           file_post_proto_goTypes,
 		DependencyIndexes: file_post_proto_depIdxs,
+		EnumInfos:         file_post_proto_enumTypes,
 		MessageInfos:      file_post_proto_msgTypes,
 	}.Build()
 	File_post_proto = out.File
@@ -20,11 +20,36 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PostService_CreatePost_FullMethodName     = "/post.PostService/CreatePost"
-	PostService_UpdatePost_FullMethodName     = "/post.PostService/UpdatePost"
-	PostService_DeletePost_FullMethodName     = "/post.PostService/DeletePost"
-	PostService_GetPostById_FullMethodName    = "/post.PostService/GetPostById"
-	PostService_GetPostsOnPage_FullMethodName = "/post.PostService/GetPostsOnPage"
+	PostService_CreatePost_FullMethodName          = "/post.PostService/CreatePost"
+	PostService_UpdatePost_FullMethodName          = "/post.PostService/UpdatePost"
+	PostService_DeletePost_FullMethodName          = "/post.PostService/DeletePost"
+	PostService_GetPostById_FullMethodName         = "/post.PostService/GetPostById"
+	PostService_GetPostsOnPage_FullMethodName      = "/post.PostService/GetPostsOnPage"
+	PostService_ListPostRevisions_FullMethodName   = "/post.PostService/ListPostRevisions"
+	PostService_GetPostRevision_FullMethodName     = "/post.PostService/GetPostRevision"
+	PostService_DiffPostRevisions_FullMethodName   = "/post.PostService/DiffPostRevisions"
+	PostService_RestorePostRevision_FullMethodName = "/post.PostService/RestorePostRevision"
+	PostService_ListTrash_FullMethodName           = "/post.PostService/ListTrash"
+	PostService_RestorePost_FullMethodName         = "/post.PostService/RestorePost"
+	PostService_CreateComment_FullMethodName       = "/post.PostService/CreateComment"
+	PostService_UpdateComment_FullMethodName       = "/post.PostService/UpdateComment"
+	PostService_DeleteComment_FullMethodName       = "/post.PostService/DeleteComment"
+	PostService_ListComments_FullMethodName        = "/post.PostService/ListComments"
+	PostService_LikeComment_FullMethodName         = "/post.PostService/LikeComment"
+	PostService_UnlikeComment_FullMethodName       = "/post.PostService/UnlikeComment"
+	PostService_GetPostsByTag_FullMethodName       = "/post.PostService/GetPostsByTag"
+	PostService_SearchPosts_FullMethodName         = "/post.PostService/SearchPosts"
+	PostService_AddAttachment_FullMethodName       = "/post.PostService/AddAttachment"
+	PostService_GetAttachment_FullMethodName       = "/post.PostService/GetAttachment"
+	PostService_DeleteAttachment_FullMethodName    = "/post.PostService/DeleteAttachment"
+	PostService_PublishPost_FullMethodName         = "/post.PostService/PublishPost"
+	PostService_ListDrafts_FullMethodName          = "/post.PostService/ListDrafts"
+	PostService_Follow_FullMethodName              = "/post.PostService/Follow"
+	PostService_Unfollow_FullMethodName            = "/post.PostService/Unfollow"
+	PostService_ListFollowing_FullMethodName       = "/post.PostService/ListFollowing"
+	PostService_GetFollowerGrowth_FullMethodName   = "/post.PostService/GetFollowerGrowth"
+	PostService_ListNotifications_FullMethodName   = "/post.PostService/ListNotifications"
+	PostService_ListMentions_FullMethodName        = "/post.PostService/ListMentions"
 )
 
 // PostServiceClient is the client API for PostService service.
@@ -32,10 +57,35 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PostServiceClient interface {
 	CreatePost(ctx context.Context, in *TCreatePostRequest, opts ...grpc.CallOption) (*TCreatePostResponse, error)
-	UpdatePost(ctx context.Context, in *TUpdatePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdatePost(ctx context.Context, in *TUpdatePostRequest, opts ...grpc.CallOption) (*TUpdatePostResponse, error)
 	DeletePost(ctx context.Context, in *TDeletePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetPostById(ctx context.Context, in *TGetPostByIdRequest, opts ...grpc.CallOption) (*TGetPostByIdResponse, error)
 	GetPostsOnPage(ctx context.Context, in *TGetPostsOnPageRequest, opts ...grpc.CallOption) (*TGetPostsOnPageResponse, error)
+	ListPostRevisions(ctx context.Context, in *TListPostRevisionsRequest, opts ...grpc.CallOption) (*TListPostRevisionsResponse, error)
+	GetPostRevision(ctx context.Context, in *TGetPostRevisionRequest, opts ...grpc.CallOption) (*TGetPostRevisionResponse, error)
+	DiffPostRevisions(ctx context.Context, in *TDiffPostRevisionsRequest, opts ...grpc.CallOption) (*TDiffPostRevisionsResponse, error)
+	RestorePostRevision(ctx context.Context, in *TRestorePostRevisionRequest, opts ...grpc.CallOption) (*TGetPostRevisionResponse, error)
+	ListTrash(ctx context.Context, in *TListTrashRequest, opts ...grpc.CallOption) (*TListTrashResponse, error)
+	RestorePost(ctx context.Context, in *TRestorePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateComment(ctx context.Context, in *TCreateCommentRequest, opts ...grpc.CallOption) (*TCommentResponse, error)
+	UpdateComment(ctx context.Context, in *TUpdateCommentRequest, opts ...grpc.CallOption) (*TCommentResponse, error)
+	DeleteComment(ctx context.Context, in *TDeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListComments(ctx context.Context, in *TListCommentsRequest, opts ...grpc.CallOption) (*TListCommentsResponse, error)
+	LikeComment(ctx context.Context, in *TLikeCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnlikeComment(ctx context.Context, in *TLikeCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPostsByTag(ctx context.Context, in *TGetPostsByTagRequest, opts ...grpc.CallOption) (*TGetPostsOnPageResponse, error)
+	SearchPosts(ctx context.Context, in *TSearchPostsRequest, opts ...grpc.CallOption) (*TSearchPostsResponse, error)
+	AddAttachment(ctx context.Context, in *TAddAttachmentRequest, opts ...grpc.CallOption) (*TAttachmentResponse, error)
+	GetAttachment(ctx context.Context, in *TGetAttachmentRequest, opts ...grpc.CallOption) (*TAttachmentResponse, error)
+	DeleteAttachment(ctx context.Context, in *TDeleteAttachmentRequest, opts ...grpc.CallOption) (*TAttachmentResponse, error)
+	PublishPost(ctx context.Context, in *TPublishPostRequest, opts ...grpc.CallOption) (*TGetPostByIdResponse, error)
+	ListDrafts(ctx context.Context, in *TListDraftsRequest, opts ...grpc.CallOption) (*TGetPostsOnPageResponse, error)
+	Follow(ctx context.Context, in *TFollowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Unfollow(ctx context.Context, in *TFollowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListFollowing(ctx context.Context, in *TListFollowingRequest, opts ...grpc.CallOption) (*TListFollowingResponse, error)
+	GetFollowerGrowth(ctx context.Context, in *TGetFollowerGrowthRequest, opts ...grpc.CallOption) (*TFollowerGrowth, error)
+	ListNotifications(ctx context.Context, in *TListNotificationsRequest, opts ...grpc.CallOption) (*TListNotificationsResponse, error)
+	ListMentions(ctx context.Context, in *TListMentionsRequest, opts ...grpc.CallOption) (*TGetPostsOnPageResponse, error)
 }
 
 type postServiceClient struct {
@@ -55,8 +105,8 @@ func (c *postServiceClient) CreatePost(ctx context.Context, in *TCreatePostReque
 	return out, nil
 }
 
-func (c *postServiceClient) UpdatePost(ctx context.Context, in *TUpdatePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *postServiceClient) UpdatePost(ctx context.Context, in *TUpdatePostRequest, opts ...grpc.CallOption) (*TUpdatePostResponse, error) {
+	out := new(TUpdatePostResponse)
 	err := c.cc.Invoke(ctx, PostService_UpdatePost_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -91,136 +141,911 @@ func (c *postServiceClient) GetPostsOnPage(ctx context.Context, in *TGetPostsOnP
 	return out, nil
 }
 
+func (c *postServiceClient) ListPostRevisions(ctx context.Context, in *TListPostRevisionsRequest, opts ...grpc.CallOption) (*TListPostRevisionsResponse, error) {
+	out := new(TListPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListPostRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostRevision(ctx context.Context, in *TGetPostRevisionRequest, opts ...grpc.CallOption) (*TGetPostRevisionResponse, error) {
+	out := new(TGetPostRevisionResponse)
+	err := c.cc.Invoke(ctx, PostService_GetPostRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) DiffPostRevisions(ctx context.Context, in *TDiffPostRevisionsRequest, opts ...grpc.CallOption) (*TDiffPostRevisionsResponse, error) {
+	out := new(TDiffPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, PostService_DiffPostRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RestorePostRevision(ctx context.Context, in *TRestorePostRevisionRequest, opts ...grpc.CallOption) (*TGetPostRevisionResponse, error) {
+	out := new(TGetPostRevisionResponse)
+	err := c.cc.Invoke(ctx, PostService_RestorePostRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListTrash(ctx context.Context, in *TListTrashRequest, opts ...grpc.CallOption) (*TListTrashResponse, error) {
+	out := new(TListTrashResponse)
+	err := c.cc.Invoke(ctx, PostService_ListTrash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RestorePost(ctx context.Context, in *TRestorePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_RestorePost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) CreateComment(ctx context.Context, in *TCreateCommentRequest, opts ...grpc.CallOption) (*TCommentResponse, error) {
+	out := new(TCommentResponse)
+	err := c.cc.Invoke(ctx, PostService_CreateComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UpdateComment(ctx context.Context, in *TUpdateCommentRequest, opts ...grpc.CallOption) (*TCommentResponse, error) {
+	out := new(TCommentResponse)
+	err := c.cc.Invoke(ctx, PostService_UpdateComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) DeleteComment(ctx context.Context, in *TDeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_DeleteComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListComments(ctx context.Context, in *TListCommentsRequest, opts ...grpc.CallOption) (*TListCommentsResponse, error) {
+	out := new(TListCommentsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) LikeComment(ctx context.Context, in *TLikeCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_LikeComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UnlikeComment(ctx context.Context, in *TLikeCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_UnlikeComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostsByTag(ctx context.Context, in *TGetPostsByTagRequest, opts ...grpc.CallOption) (*TGetPostsOnPageResponse, error) {
+	out := new(TGetPostsOnPageResponse)
+	err := c.cc.Invoke(ctx, PostService_GetPostsByTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) SearchPosts(ctx context.Context, in *TSearchPostsRequest, opts ...grpc.CallOption) (*TSearchPostsResponse, error) {
+	out := new(TSearchPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_SearchPosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) AddAttachment(ctx context.Context, in *TAddAttachmentRequest, opts ...grpc.CallOption) (*TAttachmentResponse, error) {
+	out := new(TAttachmentResponse)
+	err := c.cc.Invoke(ctx, PostService_AddAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetAttachment(ctx context.Context, in *TGetAttachmentRequest, opts ...grpc.CallOption) (*TAttachmentResponse, error) {
+	out := new(TAttachmentResponse)
+	err := c.cc.Invoke(ctx, PostService_GetAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) DeleteAttachment(ctx context.Context, in *TDeleteAttachmentRequest, opts ...grpc.CallOption) (*TAttachmentResponse, error) {
+	out := new(TAttachmentResponse)
+	err := c.cc.Invoke(ctx, PostService_DeleteAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) PublishPost(ctx context.Context, in *TPublishPostRequest, opts ...grpc.CallOption) (*TGetPostByIdResponse, error) {
+	out := new(TGetPostByIdResponse)
+	err := c.cc.Invoke(ctx, PostService_PublishPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListDrafts(ctx context.Context, in *TListDraftsRequest, opts ...grpc.CallOption) (*TGetPostsOnPageResponse, error) {
+	out := new(TGetPostsOnPageResponse)
+	err := c.cc.Invoke(ctx, PostService_ListDrafts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) Follow(ctx context.Context, in *TFollowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_Follow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) Unfollow(ctx context.Context, in *TFollowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_Unfollow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListFollowing(ctx context.Context, in *TListFollowingRequest, opts ...grpc.CallOption) (*TListFollowingResponse, error) {
+	out := new(TListFollowingResponse)
+	err := c.cc.Invoke(ctx, PostService_ListFollowing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetFollowerGrowth(ctx context.Context, in *TGetFollowerGrowthRequest, opts ...grpc.CallOption) (*TFollowerGrowth, error) {
+	out := new(TFollowerGrowth)
+	err := c.cc.Invoke(ctx, PostService_GetFollowerGrowth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListNotifications(ctx context.Context, in *TListNotificationsRequest, opts ...grpc.CallOption) (*TListNotificationsResponse, error) {
+	out := new(TListNotificationsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListMentions(ctx context.Context, in *TListMentionsRequest, opts ...grpc.CallOption) (*TGetPostsOnPageResponse, error) {
+	out := new(TGetPostsOnPageResponse)
+	err := c.cc.Invoke(ctx, PostService_ListMentions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostServiceServer is the server API for PostService service.
 // All implementations must embed UnimplementedPostServiceServer
 // for forward compatibility
 type PostServiceServer interface {
 	CreatePost(context.Context, *TCreatePostRequest) (*TCreatePostResponse, error)
-	UpdatePost(context.Context, *TUpdatePostRequest) (*emptypb.Empty, error)
+	UpdatePost(context.Context, *TUpdatePostRequest) (*TUpdatePostResponse, error)
 	DeletePost(context.Context, *TDeletePostRequest) (*emptypb.Empty, error)
 	GetPostById(context.Context, *TGetPostByIdRequest) (*TGetPostByIdResponse, error)
 	GetPostsOnPage(context.Context, *TGetPostsOnPageRequest) (*TGetPostsOnPageResponse, error)
+	ListPostRevisions(context.Context, *TListPostRevisionsRequest) (*TListPostRevisionsResponse, error)
+	GetPostRevision(context.Context, *TGetPostRevisionRequest) (*TGetPostRevisionResponse, error)
+	DiffPostRevisions(context.Context, *TDiffPostRevisionsRequest) (*TDiffPostRevisionsResponse, error)
+	RestorePostRevision(context.Context, *TRestorePostRevisionRequest) (*TGetPostRevisionResponse, error)
+	ListTrash(context.Context, *TListTrashRequest) (*TListTrashResponse, error)
+	RestorePost(context.Context, *TRestorePostRequest) (*emptypb.Empty, error)
+	CreateComment(context.Context, *TCreateCommentRequest) (*TCommentResponse, error)
+	UpdateComment(context.Context, *TUpdateCommentRequest) (*TCommentResponse, error)
+	DeleteComment(context.Context, *TDeleteCommentRequest) (*emptypb.Empty, error)
+	ListComments(context.Context, *TListCommentsRequest) (*TListCommentsResponse, error)
+	LikeComment(context.Context, *TLikeCommentRequest) (*emptypb.Empty, error)
+	UnlikeComment(context.Context, *TLikeCommentRequest) (*emptypb.Empty, error)
+	GetPostsByTag(context.Context, *TGetPostsByTagRequest) (*TGetPostsOnPageResponse, error)
+	SearchPosts(context.Context, *TSearchPostsRequest) (*TSearchPostsResponse, error)
+	AddAttachment(context.Context, *TAddAttachmentRequest) (*TAttachmentResponse, error)
+	GetAttachment(context.Context, *TGetAttachmentRequest) (*TAttachmentResponse, error)
+	DeleteAttachment(context.Context, *TDeleteAttachmentRequest) (*TAttachmentResponse, error)
+	PublishPost(context.Context, *TPublishPostRequest) (*TGetPostByIdResponse, error)
+	ListDrafts(context.Context, *TListDraftsRequest) (*TGetPostsOnPageResponse, error)
+	Follow(context.Context, *TFollowRequest) (*emptypb.Empty, error)
+	Unfollow(context.Context, *TFollowRequest) (*emptypb.Empty, error)
+	ListFollowing(context.Context, *TListFollowingRequest) (*TListFollowingResponse, error)
+	GetFollowerGrowth(context.Context, *TGetFollowerGrowthRequest) (*TFollowerGrowth, error)
+	ListNotifications(context.Context, *TListNotificationsRequest) (*TListNotificationsResponse, error)
+	ListMentions(context.Context, *TListMentionsRequest) (*TGetPostsOnPageResponse, error)
+	mustEmbedUnimplementedPostServiceServer()
+}
+
+// UnimplementedPostServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPostServiceServer struct {
+}
+
+func (UnimplementedPostServiceServer) CreatePost(context.Context, *TCreatePostRequest) (*TCreatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (UnimplementedPostServiceServer) UpdatePost(context.Context, *TUpdatePostRequest) (*TUpdatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (UnimplementedPostServiceServer) DeletePost(context.Context, *TDeletePostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedPostServiceServer) GetPostById(context.Context, *TGetPostByIdRequest) (*TGetPostByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostById not implemented")
+}
+func (UnimplementedPostServiceServer) GetPostsOnPage(context.Context, *TGetPostsOnPageRequest) (*TGetPostsOnPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsOnPage not implemented")
+}
+func (UnimplementedPostServiceServer) ListPostRevisions(context.Context, *TListPostRevisionsRequest) (*TListPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostRevisions not implemented")
+}
+func (UnimplementedPostServiceServer) GetPostRevision(context.Context, *TGetPostRevisionRequest) (*TGetPostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostRevision not implemented")
+}
+func (UnimplementedPostServiceServer) DiffPostRevisions(context.Context, *TDiffPostRevisionsRequest) (*TDiffPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffPostRevisions not implemented")
+}
+func (UnimplementedPostServiceServer) RestorePostRevision(context.Context, *TRestorePostRevisionRequest) (*TGetPostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePostRevision not implemented")
+}
+func (UnimplementedPostServiceServer) ListTrash(context.Context, *TListTrashRequest) (*TListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedPostServiceServer) RestorePost(context.Context, *TRestorePostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (UnimplementedPostServiceServer) CreateComment(context.Context, *TCreateCommentRequest) (*TCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedPostServiceServer) UpdateComment(context.Context, *TUpdateCommentRequest) (*TCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedPostServiceServer) DeleteComment(context.Context, *TDeleteCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedPostServiceServer) ListComments(context.Context, *TListCommentsRequest) (*TListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedPostServiceServer) LikeComment(context.Context, *TLikeCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+}
+func (UnimplementedPostServiceServer) UnlikeComment(context.Context, *TLikeCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeComment not implemented")
+}
+func (UnimplementedPostServiceServer) GetPostsByTag(context.Context, *TGetPostsByTagRequest) (*TGetPostsOnPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByTag not implemented")
+}
+func (UnimplementedPostServiceServer) SearchPosts(context.Context, *TSearchPostsRequest) (*TSearchPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedPostServiceServer) AddAttachment(context.Context, *TAddAttachmentRequest) (*TAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAttachment not implemented")
+}
+func (UnimplementedPostServiceServer) GetAttachment(context.Context, *TGetAttachmentRequest) (*TAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedPostServiceServer) DeleteAttachment(context.Context, *TDeleteAttachmentRequest) (*TAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedPostServiceServer) PublishPost(context.Context, *TPublishPostRequest) (*TGetPostByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPost not implemented")
+}
+func (UnimplementedPostServiceServer) ListDrafts(context.Context, *TListDraftsRequest) (*TGetPostsOnPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (UnimplementedPostServiceServer) Follow(context.Context, *TFollowRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (UnimplementedPostServiceServer) Unfollow(context.Context, *TFollowRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfollow not implemented")
+}
+func (UnimplementedPostServiceServer) ListFollowing(context.Context, *TListFollowingRequest) (*TListFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+func (UnimplementedPostServiceServer) GetFollowerGrowth(context.Context, *TGetFollowerGrowthRequest) (*TFollowerGrowth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowerGrowth not implemented")
+}
+func (UnimplementedPostServiceServer) ListNotifications(context.Context, *TListNotificationsRequest) (*TListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedPostServiceServer) ListMentions(context.Context, *TListMentionsRequest) (*TGetPostsOnPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMentions not implemented")
+}
+func (UnimplementedPostServiceServer) mustEmbedUnimplementedPostServiceServer() {}
+
+// UnsafePostServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PostServiceServer will
+// result in compilation errors.
+type UnsafePostServiceServer interface {
 	mustEmbedUnimplementedPostServiceServer()
 }
 
-// UnimplementedPostServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedPostServiceServer struct {
+func RegisterPostServiceServer(s grpc.ServiceRegistrar, srv PostServiceServer) {
+	s.RegisterService(&PostService_ServiceDesc, srv)
+}
+
+func _PostService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TCreatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).CreatePost(ctx, req.(*TCreatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TUpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UpdatePost(ctx, req.(*TUpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TDeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).DeletePost(ctx, req.(*TDeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TGetPostByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPostById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostById(ctx, req.(*TGetPostByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostsOnPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TGetPostsOnPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostsOnPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPostsOnPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostsOnPage(ctx, req.(*TGetPostsOnPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TListPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListPostRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListPostRevisions(ctx, req.(*TListPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TGetPostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostRevision(ctx, req.(*TGetPostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DiffPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TDiffPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DiffPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_DiffPostRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).DiffPostRevisions(ctx, req.(*TDiffPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_RestorePostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TRestorePostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).RestorePostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_RestorePostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).RestorePostRevision(ctx, req.(*TRestorePostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListTrash(ctx, req.(*TListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TRestorePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_RestorePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).RestorePost(ctx, req.(*TRestorePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TCreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).CreateComment(ctx, req.(*TCreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TUpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UpdateComment(ctx, req.(*TUpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TDeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).DeleteComment(ctx, req.(*TDeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListComments(ctx, req.(*TListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).LikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_LikeComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).LikeComment(ctx, req.(*TLikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UnlikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UnlikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UnlikeComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UnlikeComment(ctx, req.(*TLikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TGetPostsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPostsByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostsByTag(ctx, req.(*TGetPostsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_SearchPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SearchPosts(ctx, req.(*TSearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_AddAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TAddAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).AddAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_AddAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).AddAttachment(ctx, req.(*TAddAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TGetAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetAttachment(ctx, req.(*TGetAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TDeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).DeleteAttachment(ctx, req.(*TDeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedPostServiceServer) CreatePost(context.Context, *TCreatePostRequest) (*TCreatePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
-}
-func (UnimplementedPostServiceServer) UpdatePost(context.Context, *TUpdatePostRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
-}
-func (UnimplementedPostServiceServer) DeletePost(context.Context, *TDeletePostRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
-}
-func (UnimplementedPostServiceServer) GetPostById(context.Context, *TGetPostByIdRequest) (*TGetPostByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPostById not implemented")
-}
-func (UnimplementedPostServiceServer) GetPostsOnPage(context.Context, *TGetPostsOnPageRequest) (*TGetPostsOnPageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPostsOnPage not implemented")
+func _PostService_PublishPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TPublishPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).PublishPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_PublishPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).PublishPost(ctx, req.(*TPublishPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPostServiceServer) mustEmbedUnimplementedPostServiceServer() {}
 
-// UnsafePostServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PostServiceServer will
-// result in compilation errors.
-type UnsafePostServiceServer interface {
-	mustEmbedUnimplementedPostServiceServer()
+func _PostService_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TListDraftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListDrafts(ctx, req.(*TListDraftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPostServiceServer(s grpc.ServiceRegistrar, srv PostServiceServer) {
-	s.RegisterService(&PostService_ServiceDesc, srv)
+func _PostService_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_Follow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).Follow(ctx, req.(*TFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TCreatePostRequest)
+func _PostService_Unfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TFollowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).CreatePost(ctx, in)
+		return srv.(PostServiceServer).Unfollow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_CreatePost_FullMethodName,
+		FullMethod: PostService_Unfollow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).CreatePost(ctx, req.(*TCreatePostRequest))
+		return srv.(PostServiceServer).Unfollow(ctx, req.(*TFollowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TUpdatePostRequest)
+func _PostService_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TListFollowingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).UpdatePost(ctx, in)
+		return srv.(PostServiceServer).ListFollowing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_UpdatePost_FullMethodName,
+		FullMethod: PostService_ListFollowing_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).UpdatePost(ctx, req.(*TUpdatePostRequest))
+		return srv.(PostServiceServer).ListFollowing(ctx, req.(*TListFollowingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TDeletePostRequest)
+func _PostService_GetFollowerGrowth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TGetFollowerGrowthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).DeletePost(ctx, in)
+		return srv.(PostServiceServer).GetFollowerGrowth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_DeletePost_FullMethodName,
+		FullMethod: PostService_GetFollowerGrowth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).DeletePost(ctx, req.(*TDeletePostRequest))
+		return srv.(PostServiceServer).GetFollowerGrowth(ctx, req.(*TGetFollowerGrowthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetPostById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TGetPostByIdRequest)
+func _PostService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TListNotificationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetPostById(ctx, in)
+		return srv.(PostServiceServer).ListNotifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetPostById_FullMethodName,
+		FullMethod: PostService_ListNotifications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetPostById(ctx, req.(*TGetPostByIdRequest))
+		return srv.(PostServiceServer).ListNotifications(ctx, req.(*TListNotificationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetPostsOnPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TGetPostsOnPageRequest)
+func _PostService_ListMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TListMentionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetPostsOnPage(ctx, in)
+		return srv.(PostServiceServer).ListMentions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetPostsOnPage_FullMethodName,
+		FullMethod: PostService_ListMentions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetPostsOnPage(ctx, req.(*TGetPostsOnPageRequest))
+		return srv.(PostServiceServer).ListMentions(ctx, req.(*TListMentionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -252,16 +1077,121 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPostsOnPage",
 			Handler:    _PostService_GetPostsOnPage_Handler,
 		},
+		{
+			MethodName: "ListPostRevisions",
+			Handler:    _PostService_ListPostRevisions_Handler,
+		},
+		{
+			MethodName: "GetPostRevision",
+			Handler:    _PostService_GetPostRevision_Handler,
+		},
+		{
+			MethodName: "DiffPostRevisions",
+			Handler:    _PostService_DiffPostRevisions_Handler,
+		},
+		{
+			MethodName: "RestorePostRevision",
+			Handler:    _PostService_RestorePostRevision_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _PostService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _PostService_RestorePost_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _PostService_CreateComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _PostService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _PostService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _PostService_ListComments_Handler,
+		},
+		{
+			MethodName: "LikeComment",
+			Handler:    _PostService_LikeComment_Handler,
+		},
+		{
+			MethodName: "UnlikeComment",
+			Handler:    _PostService_UnlikeComment_Handler,
+		},
+		{
+			MethodName: "GetPostsByTag",
+			Handler:    _PostService_GetPostsByTag_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _PostService_SearchPosts_Handler,
+		},
+		{
+			MethodName: "AddAttachment",
+			Handler:    _PostService_AddAttachment_Handler,
+		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _PostService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _PostService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "PublishPost",
+			Handler:    _PostService_PublishPost_Handler,
+		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _PostService_ListDrafts_Handler,
+		},
+		{
+			MethodName: "Follow",
+			Handler:    _PostService_Follow_Handler,
+		},
+		{
+			MethodName: "Unfollow",
+			Handler:    _PostService_Unfollow_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _PostService_ListFollowing_Handler,
+		},
+		{
+			MethodName: "GetFollowerGrowth",
+			Handler:    _PostService_GetFollowerGrowth_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _PostService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "ListMentions",
+			Handler:    _PostService_ListMentions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "post.proto",
 }
 
 const (
-	StatsService_GetPostStats_FullMethodName  = "/post.StatsService/GetPostStats"
-	StatsService_GetTopPosts_FullMethodName   = "/post.StatsService/GetTopPosts"
-	StatsService_GetTopAuthors_FullMethodName = "/post.StatsService/GetTopAuthors"
-	StatsService_AddPost_FullMethodName       = "/post.StatsService/AddPost"
+	StatsService_GetPostStats_FullMethodName       = "/post.StatsService/GetPostStats"
+	StatsService_GetPostTimeSeries_FullMethodName  = "/post.StatsService/GetPostTimeSeries"
+	StatsService_GetTopPosts_FullMethodName        = "/post.StatsService/GetTopPosts"
+	StatsService_GetPollResults_FullMethodName     = "/post.StatsService/GetPollResults"
+	StatsService_GetViewerLikes_FullMethodName     = "/post.StatsService/GetViewerLikes"
+	StatsService_GetTopAuthors_FullMethodName      = "/post.StatsService/GetTopAuthors"
+	StatsService_GetAuthorAnalytics_FullMethodName = "/post.StatsService/GetAuthorAnalytics"
+	StatsService_AddPost_FullMethodName            = "/post.StatsService/AddPost"
+	StatsService_GetTrendingTags_FullMethodName    = "/post.StatsService/GetTrendingTags"
 )
 
 // StatsServiceClient is the client API for StatsService service.
@@ -269,9 +1199,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type StatsServiceClient interface {
 	GetPostStats(ctx context.Context, in *TGetPostStatsRequest, opts ...grpc.CallOption) (*TGetPostStatsResponse, error)
+	GetPostTimeSeries(ctx context.Context, in *TGetPostTimeSeriesRequest, opts ...grpc.CallOption) (*TGetPostTimeSeriesResponse, error)
 	GetTopPosts(ctx context.Context, in *TGetTopPostsRequest, opts ...grpc.CallOption) (*TGetTopPostsResponse, error)
-	GetTopAuthors(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TGetTopAuthorsResponse, error)
+	GetPollResults(ctx context.Context, in *TGetPollResultsRequest, opts ...grpc.CallOption) (*TGetPollResultsResponse, error)
+	GetViewerLikes(ctx context.Context, in *TGetViewerLikesRequest, opts ...grpc.CallOption) (*TGetViewerLikesResponse, error)
+	GetTopAuthors(ctx context.Context, in *TGetTopAuthorsRequest, opts ...grpc.CallOption) (*TGetTopAuthorsResponse, error)
+	GetAuthorAnalytics(ctx context.Context, in *TGetAuthorAnalyticsRequest, opts ...grpc.CallOption) (*TGetAuthorAnalyticsResponse, error)
 	AddPost(ctx context.Context, in *TAddPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetTrendingTags(ctx context.Context, in *TGetTrendingTagsRequest, opts ...grpc.CallOption) (*TGetTrendingTagsResponse, error)
 }
 
 type statsServiceClient struct {
@@ -291,6 +1226,15 @@ func (c *statsServiceClient) GetPostStats(ctx context.Context, in *TGetPostStats
 	return out, nil
 }
 
+func (c *statsServiceClient) GetPostTimeSeries(ctx context.Context, in *TGetPostTimeSeriesRequest, opts ...grpc.CallOption) (*TGetPostTimeSeriesResponse, error) {
+	out := new(TGetPostTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, StatsService_GetPostTimeSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *statsServiceClient) GetTopPosts(ctx context.Context, in *TGetTopPostsRequest, opts ...grpc.CallOption) (*TGetTopPostsResponse, error) {
 	out := new(TGetTopPostsResponse)
 	err := c.cc.Invoke(ctx, StatsService_GetTopPosts_FullMethodName, in, out, opts...)
@@ -300,7 +1244,25 @@ func (c *statsServiceClient) GetTopPosts(ctx context.Context, in *TGetTopPostsRe
 	return out, nil
 }
 
-func (c *statsServiceClient) GetTopAuthors(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TGetTopAuthorsResponse, error) {
+func (c *statsServiceClient) GetPollResults(ctx context.Context, in *TGetPollResultsRequest, opts ...grpc.CallOption) (*TGetPollResultsResponse, error) {
+	out := new(TGetPollResultsResponse)
+	err := c.cc.Invoke(ctx, StatsService_GetPollResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statsServiceClient) GetViewerLikes(ctx context.Context, in *TGetViewerLikesRequest, opts ...grpc.CallOption) (*TGetViewerLikesResponse, error) {
+	out := new(TGetViewerLikesResponse)
+	err := c.cc.Invoke(ctx, StatsService_GetViewerLikes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statsServiceClient) GetTopAuthors(ctx context.Context, in *TGetTopAuthorsRequest, opts ...grpc.CallOption) (*TGetTopAuthorsResponse, error) {
 	out := new(TGetTopAuthorsResponse)
 	err := c.cc.Invoke(ctx, StatsService_GetTopAuthors_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -309,6 +1271,15 @@ func (c *statsServiceClient) GetTopAuthors(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
+func (c *statsServiceClient) GetAuthorAnalytics(ctx context.Context, in *TGetAuthorAnalyticsRequest, opts ...grpc.CallOption) (*TGetAuthorAnalyticsResponse, error) {
+	out := new(TGetAuthorAnalyticsResponse)
+	err := c.cc.Invoke(ctx, StatsService_GetAuthorAnalytics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *statsServiceClient) AddPost(ctx context.Context, in *TAddPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, StatsService_AddPost_FullMethodName, in, out, opts...)
@@ -318,14 +1289,28 @@ func (c *statsServiceClient) AddPost(ctx context.Context, in *TAddPostRequest, o
 	return out, nil
 }
 
+func (c *statsServiceClient) GetTrendingTags(ctx context.Context, in *TGetTrendingTagsRequest, opts ...grpc.CallOption) (*TGetTrendingTagsResponse, error) {
+	out := new(TGetTrendingTagsResponse)
+	err := c.cc.Invoke(ctx, StatsService_GetTrendingTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StatsServiceServer is the server API for StatsService service.
 // All implementations must embed UnimplementedStatsServiceServer
 // for forward compatibility
 type StatsServiceServer interface {
 	GetPostStats(context.Context, *TGetPostStatsRequest) (*TGetPostStatsResponse, error)
+	GetPostTimeSeries(context.Context, *TGetPostTimeSeriesRequest) (*TGetPostTimeSeriesResponse, error)
 	GetTopPosts(context.Context, *TGetTopPostsRequest) (*TGetTopPostsResponse, error)
-	GetTopAuthors(context.Context, *emptypb.Empty) (*TGetTopAuthorsResponse, error)
+	GetPollResults(context.Context, *TGetPollResultsRequest) (*TGetPollResultsResponse, error)
+	GetViewerLikes(context.Context, *TGetViewerLikesRequest) (*TGetViewerLikesResponse, error)
+	GetTopAuthors(context.Context, *TGetTopAuthorsRequest) (*TGetTopAuthorsResponse, error)
+	GetAuthorAnalytics(context.Context, *TGetAuthorAnalyticsRequest) (*TGetAuthorAnalyticsResponse, error)
 	AddPost(context.Context, *TAddPostRequest) (*emptypb.Empty, error)
+	GetTrendingTags(context.Context, *TGetTrendingTagsRequest) (*TGetTrendingTagsResponse, error)
 	mustEmbedUnimplementedStatsServiceServer()
 }
 
@@ -336,15 +1321,30 @@ type UnimplementedStatsServiceServer struct {
 func (UnimplementedStatsServiceServer) GetPostStats(context.Context, *TGetPostStatsRequest) (*TGetPostStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPostStats not implemented")
 }
+func (UnimplementedStatsServiceServer) GetPostTimeSeries(context.Context, *TGetPostTimeSeriesRequest) (*TGetPostTimeSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostTimeSeries not implemented")
+}
 func (UnimplementedStatsServiceServer) GetTopPosts(context.Context, *TGetTopPostsRequest) (*TGetTopPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopPosts not implemented")
 }
-func (UnimplementedStatsServiceServer) GetTopAuthors(context.Context, *emptypb.Empty) (*TGetTopAuthorsResponse, error) {
+func (UnimplementedStatsServiceServer) GetPollResults(context.Context, *TGetPollResultsRequest) (*TGetPollResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPollResults not implemented")
+}
+func (UnimplementedStatsServiceServer) GetViewerLikes(context.Context, *TGetViewerLikesRequest) (*TGetViewerLikesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetViewerLikes not implemented")
+}
+func (UnimplementedStatsServiceServer) GetTopAuthors(context.Context, *TGetTopAuthorsRequest) (*TGetTopAuthorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopAuthors not implemented")
 }
+func (UnimplementedStatsServiceServer) GetAuthorAnalytics(context.Context, *TGetAuthorAnalyticsRequest) (*TGetAuthorAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorAnalytics not implemented")
+}
 func (UnimplementedStatsServiceServer) AddPost(context.Context, *TAddPostRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPost not implemented")
 }
+func (UnimplementedStatsServiceServer) GetTrendingTags(context.Context, *TGetTrendingTagsRequest) (*TGetTrendingTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingTags not implemented")
+}
 func (UnimplementedStatsServiceServer) mustEmbedUnimplementedStatsServiceServer() {}
 
 // UnsafeStatsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -376,6 +1376,24 @@ func _StatsService_GetPostStats_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StatsService_GetPostTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TGetPostTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatsServiceServer).GetPostTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatsService_GetPostTimeSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatsServiceServer).GetPostTimeSeries(ctx, req.(*TGetPostTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StatsService_GetTopPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TGetTopPostsRequest)
 	if err := dec(in); err != nil {
@@ -394,8 +1412,44 @@ func _StatsService_GetTopPosts_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StatsService_GetPollResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TGetPollResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatsServiceServer).GetPollResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatsService_GetPollResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatsServiceServer).GetPollResults(ctx, req.(*TGetPollResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatsService_GetViewerLikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TGetViewerLikesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatsServiceServer).GetViewerLikes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatsService_GetViewerLikes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatsServiceServer).GetViewerLikes(ctx, req.(*TGetViewerLikesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StatsService_GetTopAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(TGetTopAuthorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -407,7 +1461,25 @@ func _StatsService_GetTopAuthors_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: StatsService_GetTopAuthors_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StatsServiceServer).GetTopAuthors(ctx, req.(*emptypb.Empty))
+		return srv.(StatsServiceServer).GetTopAuthors(ctx, req.(*TGetTopAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatsService_GetAuthorAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TGetAuthorAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatsServiceServer).GetAuthorAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatsService_GetAuthorAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatsServiceServer).GetAuthorAnalytics(ctx, req.(*TGetAuthorAnalyticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -430,6 +1502,24 @@ func _StatsService_AddPost_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StatsService_GetTrendingTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TGetTrendingTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatsServiceServer).GetTrendingTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatsService_GetTrendingTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatsServiceServer).GetTrendingTags(ctx, req.(*TGetTrendingTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StatsService_ServiceDesc is the grpc.ServiceDesc for StatsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -441,18 +1531,38 @@ var StatsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPostStats",
 			Handler:    _StatsService_GetPostStats_Handler,
 		},
+		{
+			MethodName: "GetPostTimeSeries",
+			Handler:    _StatsService_GetPostTimeSeries_Handler,
+		},
 		{
 			MethodName: "GetTopPosts",
 			Handler:    _StatsService_GetTopPosts_Handler,
 		},
+		{
+			MethodName: "GetPollResults",
+			Handler:    _StatsService_GetPollResults_Handler,
+		},
+		{
+			MethodName: "GetViewerLikes",
+			Handler:    _StatsService_GetViewerLikes_Handler,
+		},
 		{
 			MethodName: "GetTopAuthors",
 			Handler:    _StatsService_GetTopAuthors_Handler,
 		},
+		{
+			MethodName: "GetAuthorAnalytics",
+			Handler:    _StatsService_GetAuthorAnalytics_Handler,
+		},
 		{
 			MethodName: "AddPost",
 			Handler:    _StatsService_AddPost_Handler,
 		},
+		{
+			MethodName: "GetTrendingTags",
+			Handler:    _StatsService_GetTrendingTags_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "post.proto",
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+
+	"better_errors"
+)
+
+// A materialized view that aggregates the rows inserted into post_stats into one of the aggregate tables.
+// The same query rebuilds the table from the raw rows.
+type aggregateView struct {
+	name    string
+	table   string
+	columns string
+	query   string
+}
+
+// Stats per post per hour, rankings of authors join post_author when they are read. Unique viewers have views of
+// their own, as only the views of logged in users are counted there. Likes of post_likes are not aggregated, as an
+// unlike replaces the like of the same user and post_likes already keeps a row per user.
+var aggregateViews = []aggregateView{
+	{
+		name:    "post_stats_hourly_mv",
+		table:   "post_stats_hourly",
+		columns: "post_id, hour, views, likes",
+		query: `
+		SELECT post_id, toStartOfHour(timestamp) AS hour, sum(viewed) AS views, sum(liked) AS likes
+		FROM post_stats
+		GROUP BY post_id, hour`,
+	},
+	{
+		name:    "post_stats_hourly_viewers_mv",
+		table:   "post_stats_hourly",
+		columns: "post_id, hour, viewers",
+		query: `
+		SELECT post_id, toStartOfHour(timestamp) AS hour, uniqCombinedState(login) AS viewers
+		FROM post_stats
+		WHERE viewed > 0 AND login != ''
+		GROUP BY post_id, hour`,
+	},
+}
+
+// Created by the replica that fills the aggregate tables on its first start. CREATE TABLE fails for the others,
+// so replicas starting together never count the same rows twice.
+const backfillMarker = "post_stats_hourly_backfilled"
+
+// ClickHouse error code of creating a table that exists
+const tableAlreadyExists = 57
+
+// Creates the aggregate tables and their views. The first replica to start fills them from the post_stats rows
+// stored before, so the stats consumers have to start only after this.
+func CreateAggregates() {
+	err := db.Exec(context.Background(), `
+		CREATE TABLE IF NOT EXISTS post_stats_hourly (
+			post_id UInt64,
+			hour DateTime,
+			views SimpleAggregateFunction(sum, UInt64),
+			likes SimpleAggregateFunction(sum, UInt64),
+			viewers AggregateFunction(uniqCombined, String)
+		) engine = AggregatingMergeTree()
+		ORDER BY (post_id, hour);
+	`)
+	better_errors.CheckErrorFatal(err, "error on creating post_stats_hourly")
+	for _, view := range aggregateViews {
+		err = db.Exec(context.Background(), `CREATE MATERIALIZED VIEW IF NOT EXISTS `+view.name+` TO `+view.table+` AS `+view.query)
+		better_errors.CheckErrorFatal(err, "error on creating %v", view.name)
+	}
+	if claimBackfill() {
+		if err := backfillAggregates(context.Background()); err != nil {
+			// the next start tries again
+			better_errors.CheckError(db.Exec(context.Background(), `DROP TABLE IF EXISTS `+backfillMarker), "failed to drop %v", backfillMarker)
+			better_errors.CheckErrorFatal(err, "error on backfilling aggregates")
+		}
+	}
+
+	// authors are resolved when the rankings are read, so that stats of posts published later are not lost
+	for _, view := range []string{"author_stats_daily_mv", "author_stats_daily_viewers_mv"} {
+		err = db.Exec(context.Background(), `DROP VIEW IF EXISTS `+view)
+		better_errors.CheckErrorFatal(err, "error on dropping %v", view)
+	}
+	err = db.Exec(context.Background(), `DROP TABLE IF EXISTS author_stats_daily`)
+	better_errors.CheckErrorFatal(err, "error on dropping author_stats_daily")
+
+	// unique viewers per post are kept by post_stats_hourly now
+	err = db.Exec(context.Background(), `DROP VIEW IF EXISTS post_unique_viewers_mv`)
+	better_errors.CheckErrorFatal(err, "error on dropping post_unique_viewers_mv")
+	err = db.Exec(context.Background(), `DROP TABLE IF EXISTS post_unique_viewers`)
+	better_errors.CheckErrorFatal(err, "error on dropping post_unique_viewers")
+}
+
+// Reports whether this replica created the backfill marker and so has to fill the aggregate tables.
+func claimBackfill() bool {
+	err := db.Exec(context.Background(), `CREATE TABLE `+backfillMarker+` (backfilled_at DateTime DEFAULT now()) engine = Log`)
+	var exception *clickhouse.Exception
+	if errors.As(err, &exception) && exception.Code == tableAlreadyExists {
+		return false
+	}
+	better_errors.CheckErrorFatal(err, "error on creating %v", backfillMarker)
+	return true
+}
+
+// Runs `stats_service aggregates backfill`, which rebuilds the aggregate tables from post_stats. The rows inserted
+// meanwhile would be counted twice, so the replicas of stats_service should be stopped while it runs, the stats
+// wait in StatsTopic until they are back.
+func RunAggregatesCommand(args []string) {
+	if len(args) != 1 || args[0] != "backfill" {
+		fmt.Fprintln(os.Stderr, "usage: stats_service aggregates backfill")
+		os.Exit(2)
+	}
+	better_errors.CheckErrorFatal(backfillAggregates(context.Background()), "failed to backfill aggregates")
+	fmt.Println("Rebuilt post_stats_hourly")
+}
+
+// Empties the aggregate tables and fills them with the queries of their views.
+func backfillAggregates(ctx context.Context) error {
+	truncated := map[string]bool{}
+	for _, view := range aggregateViews {
+		if !truncated[view.table] {
+			if err := db.Exec(ctx, `TRUNCATE TABLE `+view.table); err != nil {
+				return fmt.Errorf("failed to truncate %v: %w", view.table, err)
+			}
+			truncated[view.table] = true
+		}
+		log.Printf("Backfilling %v with %v", view.table, view.name)
+		if err := db.Exec(ctx, `INSERT INTO `+view.table+` (`+view.columns+`) `+view.query); err != nil {
+			return fmt.Errorf("failed to backfill %v with %v: %w", view.table, view.name, err)
+		}
+	}
+	return nil
+}
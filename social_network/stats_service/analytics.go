@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "proto"
+)
+
+// Restricts a table aliased as s to the posts of @author. Analytics are read from the raw rows, so that windows
+// are exact and not widened to the buckets of the aggregates.
+const authorPostsJoin = `INNER JOIN (SELECT post_id FROM post_author FINAL WHERE author_login = @author) AS pa ON s.post_id = pa.post_id`
+
+func (s *server) GetAuthorAnalytics(ctx context.Context, request *pb.TGetAuthorAnalyticsRequest) (*pb.TGetAuthorAnalyticsResponse, error) {
+	orderColumn, ok := topOrderColumns[request.OrderBy]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown order %v", request.OrderBy)
+	}
+	limit := request.TopPostsLimit
+	if limit == 0 {
+		limit = DefaultTopLimit
+	}
+	if limit > MaxTopLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit should be at most %d", MaxTopLimit)
+	}
+	from, to, err := windowBounds(request.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.TGetAuthorAnalyticsResponse{
+		AuthorLogin: request.AuthorLogin,
+		From:        timestamppb.New(from),
+		To:          timestamppb.New(to),
+	}
+	if response.AllTime, err = authorTotals(ctx, request.AuthorLogin, time.Unix(0, 0), to); err != nil {
+		return nil, err
+	}
+	if response.Window, err = authorTotals(ctx, request.AuthorLogin, from, to); err != nil {
+		return nil, err
+	}
+	if response.PreviousWindow, err = authorTotals(ctx, request.AuthorLogin, from.Add(-to.Sub(from)), from); err != nil {
+		return nil, err
+	}
+	response.Change = &pb.TGetAuthorAnalyticsResponse_TChange{
+		Views:          int64(response.Window.Views) - int64(response.PreviousWindow.Views),
+		Likes:          int64(response.Window.Likes) - int64(response.PreviousWindow.Likes),
+		UniqueViewers:  int64(response.Window.UniqueViewers) - int64(response.PreviousWindow.UniqueViewers),
+		EngagementRate: response.Window.EngagementRate - response.PreviousWindow.EngagementRate,
+	}
+	if response.TopPosts, err = authorTopPosts(ctx, request.AuthorLogin, from, to, orderColumn, limit); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Views, likes and unique viewers of all the posts of the author within [from, to).
+func authorTotals(ctx context.Context, author string, from time.Time, to time.Time) (*pb.TAuthorTotals, error) {
+	row := db.QueryRow(ctx, `
+	SELECT
+		sum(s.viewed),
+		sum(s.liked) + (
+			SELECT count() FROM post_likes AS s FINAL `+authorPostsJoin+`
+			WHERE s.liked AND s.updated_at >= @from AND s.updated_at < @to
+		),
+		uniqCombinedIf(s.login, s.viewed > 0 AND s.login != '')
+	FROM post_stats AS s `+authorPostsJoin+`
+	WHERE s.timestamp >= @from AND s.timestamp < @to
+	`, clickhouse.Named("author", author), clickhouse.Named("from", from), clickhouse.Named("to", to))
+
+	totals := &pb.TAuthorTotals{}
+	if err := row.Scan(&totals.Views, &totals.Likes, &totals.UniqueViewers); err != nil {
+		log.Printf("failed to get totals of %v: %v", author, err)
+		return nil, err
+	}
+	if totals.Views != 0 {
+		totals.EngagementRate = float64(totals.Likes) / float64(totals.Views)
+	}
+	return totals, nil
+}
+
+func authorTopPosts(ctx context.Context, author string, from time.Time, to time.Time, orderColumn string, limit uint32) ([]*pb.TGetTopPostsResponse_TPostStat, error) {
+	rows, err := db.Query(ctx, `
+	SELECT
+		post_id,
+		sum(l) AS total_likes,
+		sum(v) AS total_views,
+		sum(u) AS total_unique_viewers,
+		if(total_views = 0, 0, total_likes / total_views) AS engagement_rate
+	FROM (
+		SELECT s.post_id AS post_id, sum(s.viewed) AS v, sum(s.liked) AS l, uniqCombinedIf(s.login, s.viewed > 0 AND s.login != '') AS u
+		FROM post_stats AS s `+authorPostsJoin+`
+		WHERE s.timestamp >= @from AND s.timestamp < @to
+		GROUP BY post_id
+		UNION ALL
+		SELECT s.post_id AS post_id, toUInt64(0) AS v, count() AS l, toUInt64(0) AS u
+		FROM post_likes AS s FINAL `+authorPostsJoin+`
+		WHERE s.liked AND s.updated_at >= @from AND s.updated_at < @to
+		GROUP BY post_id
+	)
+	GROUP BY post_id
+	ORDER BY `+orderColumn+` DESC, post_id DESC
+	LIMIT @limit
+	`, clickhouse.Named("author", author), clickhouse.Named("from", from), clickhouse.Named("to", to), clickhouse.Named("limit", limit))
+	if err != nil {
+		log.Printf("failed to get top posts of %v: %v", author, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var posts []*pb.TGetTopPostsResponse_TPostStat
+	for rows.Next() {
+		post := &pb.TGetTopPostsResponse_TPostStat{AuthorLogin: author}
+		err := rows.Scan(&post.PostId, &post.Likes, &post.Views, &post.UniqueViewers, &post.EngagementRate)
+		if err != nil {
+			log.Printf("error reading row: %v", err)
+			return nil, err
+		}
+		posts = append(posts, post)
+	}
+	if err = rows.Err(); err != nil {
+		log.Printf("error iterating over rows: %v", err)
+		return nil, err
+	}
+	return posts, nil
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"log"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	pb "proto"
+)
+
+// Published at /debug/vars of the http server
+var (
+	batchesFlushed    = expvar.NewInt("stats_batches_flushed")
+	rowsFlushed       = expvar.NewInt("stats_rows_flushed")
+	lastBatchRows     = expvar.NewInt("stats_last_batch_rows")
+	lastFlushMillis   = expvar.NewInt("stats_last_flush_ms")
+	lastRowsPerSecond = expvar.NewFloat("stats_last_batch_rows_per_second")
+	writeRetries      = expvar.NewInt("stats_write_retries")
+)
+
+// Failed writes are retried with exponential backoff
+const (
+	writeAttempts   = 5
+	retryBackoff    = 100 * time.Millisecond
+	maxRetryBackoff = 10 * time.Second
+)
+
+type statsRow struct {
+	stats *pb.TPostStats
+	at    time.Time
+	msg   *sarama.ConsumerMessage
+}
+
+// Stats of one partition consumed since the last flush.
+type statsBatch struct {
+	rows    []statsRow
+	last    *sarama.ConsumerMessage
+	started time.Time
+}
+
+func (b *statsBatch) add(stats *pb.TPostStats, msg *sarama.ConsumerMessage) {
+	if len(b.rows) == 0 {
+		b.started = time.Now()
+	}
+	// the time of the message stays the same when it is redelivered
+	at := msg.Timestamp
+	if at.IsZero() {
+		at = time.Now()
+	}
+	b.rows = append(b.rows, statsRow{stats: stats, at: at, msg: msg})
+	b.last = msg
+}
+
+// Moves the offset past a message that went to the dead letter topic instead of the batch.
+func (b *statsBatch) skip(msg *sarama.ConsumerMessage) {
+	b.last = msg
+}
+
+func isLike(stats *pb.TPostStats) bool {
+	return stats.Login != "" && (stats.Liked != 0 || stats.Unliked != 0)
+}
+
+// Writes the batch with one insert per table. A like is a row per user and post, the one with the latest
+// updated_at survives the merge. The time comes from main_service, so a redelivered message replaces its own row
+// and an old like never overrides an unlike.
+func writeRows(ctx context.Context, rows []statsRow) error {
+	var views, likes [][]any
+	for _, row := range rows {
+		stats := row.stats
+		if isLike(stats) {
+			updatedAt := row.at
+			if stats.Timestamp != nil {
+				updatedAt = stats.Timestamp.AsTime()
+			}
+			likes = append(likes, []any{stats.PostId, stats.Login, stats.Unliked == 0, updatedAt})
+		} else {
+			views = append(views, []any{stats.PostId, stats.Viewed, stats.Liked, row.at, stats.Login})
+		}
+	}
+	if err := sendBatch(ctx, "INSERT INTO post_stats (post_id, viewed, liked, timestamp, login)", views); err != nil {
+		return err
+	}
+	return sendBatch(ctx, "INSERT INTO post_likes (post_id, login, liked, updated_at)", likes)
+}
+
+func sendBatch(ctx context.Context, query string, rows [][]any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	batch, err := db.PrepareBatch(ctx, query)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := batch.Append(row...); err != nil {
+			batch.Abort()
+			return err
+		}
+	}
+	return batch.Send()
+}
+
+// Retries the write while it fails. Once the attempts are used up while ClickHouse is reachable, the batch is
+// written row by row and the rows ClickHouse still rejects go to the dead letter topic. While ClickHouse is down
+// the batch keeps waiting, so that transient outages never send anything to the dead letter topic.
+func (b *statsBatch) write(ctx context.Context) error {
+	backoff := retryBackoff
+	for attempt := 1; ; attempt++ {
+		err := writeRows(ctx, b.rows)
+		if err == nil {
+			return nil
+		}
+		if attempt >= writeAttempts && db.Ping(ctx) == nil {
+			log.Printf("Writing %d stats rows one by one after %d attempts: %v", len(b.rows), attempt, err)
+			return b.writeOneByOne(ctx)
+		}
+		log.Printf("Failed to write %d stats rows, attempt %d: %v", len(b.rows), attempt, err)
+		writeRetries.Add(1)
+		time.Sleep(backoff)
+		backoff = min(2*backoff, maxRetryBackoff)
+	}
+}
+
+func (b *statsBatch) writeOneByOne(ctx context.Context) error {
+	for _, row := range b.rows {
+		err := writeRows(ctx, []statsRow{row})
+		if err == nil {
+			continue
+		}
+		if err := sendToDLQ(row.msg, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Writes the batch and commits its offsets afterwards, so the committed offset never gets ahead of the data.
+// Messages written before a crash and not committed yet are delivered again.
+func (b *statsBatch) flush(session sarama.ConsumerGroupSession) error {
+	if b.last == nil {
+		return nil
+	}
+	start := time.Now()
+	// the rows are written even if the session ends meanwhile, they are delivered again then
+	if err := b.write(context.Background()); err != nil {
+		return err
+	}
+	session.MarkMessage(b.last, "")
+	session.Commit()
+	b.last = nil
+	if len(b.rows) == 0 {
+		return nil
+	}
+
+	batchesFlushed.Add(1)
+	rowsFlushed.Add(int64(len(b.rows)))
+	lastBatchRows.Set(int64(len(b.rows)))
+	lastFlushMillis.Set(time.Since(start).Milliseconds())
+	lastRowsPerSecond.Set(float64(len(b.rows)) / time.Since(b.started).Seconds())
+
+	b.rows = b.rows[:0]
+	return nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"better_errors"
+)
+
+const statsTopic = "StatsTopic"
+
+// Makes sure StatsTopic has enough partitions for the replicas of stats_service to share. The topic may have been
+// auto-created with a single partition by the first view, so it is grown instead of being left as is.
+func EnsureStatsTopic(partitions int32) {
+	admin, err := sarama.NewClusterAdmin([]string{"kafka:9092"}, sarama.NewConfig())
+	better_errors.CheckErrorFatal(err, "failed to create kafka cluster admin")
+	defer func() {
+		better_errors.CheckError(admin.Close(), "failed to close kafka cluster admin")
+	}()
+
+	metadata, err := admin.DescribeTopics([]string{statsTopic})
+	better_errors.CheckErrorFatal(err, "failed to describe %v", statsTopic)
+	if len(metadata) == 0 || errors.Is(metadata[0].Err, sarama.ErrUnknownTopicOrPartition) {
+		err = admin.CreateTopic(statsTopic, &sarama.TopicDetail{NumPartitions: partitions, ReplicationFactor: 1}, false)
+		if !errors.Is(err, sarama.ErrTopicAlreadyExists) {
+			better_errors.CheckErrorFatal(err, "failed to create %v", statsTopic)
+		}
+		return
+	}
+	if current := int32(len(metadata[0].Partitions)); current < partitions {
+		log.Printf("Growing %v from %d to %d partitions", statsTopic, current, partitions)
+		err = admin.CreatePartitions(statsTopic, partitions, nil, false)
+		better_errors.CheckErrorFatal(err, "failed to add partitions to %v", statsTopic)
+	}
+}
+
+// Replicas of stats_service share the partitions of a topic as members of one consumer group.
+// A new group starts from the oldest retained message, after that it resumes from the committed offsets.
+func newConsumerGroup(groupId string) sarama.ConsumerGroup {
+	config := sarama.NewConfig()
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	// offsets are committed once the messages are written
+	config.Consumer.Offsets.AutoCommit.Enable = false
+	// sticky assignment keeps the partitions where they are when a replica joins or leaves
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategySticky()}
+
+	group, err := sarama.NewConsumerGroup([]string{"kafka:9092"}, groupId, config)
+	better_errors.CheckErrorFatal(err, "failed to create kafka consumer group %v", groupId)
+	return group
+}
+
+// Consumes the topic until ctx is done, then leaves the group so that the partitions are reassigned right away.
+func consumeGroup(ctx context.Context, groupId string, topic string, handler sarama.ConsumerGroupHandler) {
+	group := newConsumerGroup(groupId)
+	defer func() {
+		better_errors.CheckErrorFatal(group.Close(), "failed to close kafka consumer group %v", groupId)
+	}()
+
+	// Consume returns on every rebalance and is called again to rejoin the group. Broker and connection errors
+	// pass once the broker is back, so they are retried, only a closed group or a bad config stop the service.
+	backoff := retryBackoff
+	for ctx.Err() == nil {
+		err := group.Consume(ctx, []string{topic}, handler)
+		if err == nil {
+			backoff = retryBackoff
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		var configErr sarama.ConfigurationError
+		if errors.Is(err, sarama.ErrClosedConsumerGroup) || errors.As(err, &configErr) {
+			better_errors.CheckErrorPanic(err, "kafka consumer group %v failed", groupId)
+		}
+		log.Printf("Kafka consumer group %v failed, retrying in %v: %v", groupId, backoff, err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxRetryBackoff)
+	}
+}
+
+// Consumes StatsTopic in batches, returns once ctx is done.
+func ConsumeKafka(ctx context.Context, groupId string) {
+	consumeGroup(ctx, groupId, statsTopic, statsConsumer{})
+}
+
+type statsConsumer struct{}
+
+func (statsConsumer) Setup(session sarama.ConsumerGroupSession) error {
+	log.Printf("Consuming %v partitions %v", statsTopic, session.Claims()[statsTopic])
+	return nil
+}
+
+func (statsConsumer) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// Stats are written in batches of up to batchSize rows, a batch that is not full is written after batchInterval.
+// Each partition has its own batch, so offsets are committed for the partition that was written.
+// Messages that can not be parsed go to the dead letter topic right away and do not stop the partition.
+func (statsConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ticker := time.NewTicker(*batchInterval)
+	defer ticker.Stop()
+
+	var batch statsBatch
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				// the partition is taken away on rebalance, what was consumed is written before giving it up
+				return batch.flush(session)
+			}
+			statsUpdate, err := parseStatsMessage(msg)
+			if err != nil {
+				better_errors.CheckErrorPanic(sendToDLQ(msg, err), "failed to send message to %v%v", statsTopic, dlqSuffix)
+				batch.skip(msg)
+				continue
+			}
+
+			batch.add(statsUpdate, msg)
+			if len(batch.rows) >= *batchSize {
+				better_errors.CheckErrorPanic(batch.flush(session), "failed to write stats batch")
+			}
+		case <-ticker.C:
+			better_errors.CheckErrorPanic(batch.flush(session), "failed to write stats batch")
+		}
+	}
+}
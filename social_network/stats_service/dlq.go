@@ -0,0 +1,219 @@
+package main
+
+import (
+	"expvar"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"better_errors"
+	pb "proto"
+)
+
+// Messages that can not be stored go to the dead letter topic of their topic, e.g. StatsTopic.DLQ, with the reason
+// and their origin in the headers
+const dlqSuffix = ".DLQ"
+
+// Topics that have a dead letter topic and the messages they carry, for inspection
+var dlqMessages = map[string]func() proto.Message{
+	statsTopic:      func() proto.Message { return &pb.TPostStats{} },
+	postEventsTopic: func() proto.Message { return &pb.TPostEvent{} },
+	pollVotesTopic:  func() proto.Message { return &pb.TPollVote{} },
+}
+
+// Replay progress is stored as the offsets of this group, so a message is replayed once
+const dlqReplayGroup = "stats_service.dlq_replay"
+
+const (
+	dlqErrorHeader     = "error"
+	dlqPartitionHeader = "original_partition"
+	dlqOffsetHeader    = "original_offset"
+	dlqFailedAtHeader  = "failed_at"
+)
+
+var (
+	dlqProducer sarama.SyncProducer
+
+	deadLetters = expvar.NewInt("stats_dead_letters")
+)
+
+func NewDLQProducer() sarama.SyncProducer {
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	producer, err := sarama.NewSyncProducer([]string{"kafka:9092"}, config)
+	better_errors.CheckErrorFatal(err, "failed to create dead letter producer")
+	return producer
+}
+
+// Parks a message that can not be stored, the original key and headers are kept so that it can be replayed as is.
+func sendToDLQ(msg *sarama.ConsumerMessage, reason error) error {
+	dlqTopic := msg.Topic + dlqSuffix
+	log.Printf("Sending message %v partition %d offset %d to %v: %v", msg.Topic, msg.Partition, msg.Offset, dlqTopic, reason)
+	headers := []sarama.RecordHeader{
+		{Key: []byte(dlqErrorHeader), Value: []byte(reason.Error())},
+		{Key: []byte(dlqPartitionHeader), Value: []byte(strconv.FormatInt(int64(msg.Partition), 10))},
+		{Key: []byte(dlqOffsetHeader), Value: []byte(strconv.FormatInt(msg.Offset, 10))},
+		{Key: []byte(dlqFailedAtHeader), Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+	}
+	for _, header := range msg.Headers {
+		headers = append(headers, *header)
+	}
+	_, _, err := dlqProducer.SendMessage(&sarama.ProducerMessage{
+		Topic:   dlqTopic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: headers,
+	})
+	if err == nil {
+		deadLetters.Add(1)
+	}
+	return err
+}
+
+// Tells apart messages that will never be stored, the rest are retried.
+func parseStatsMessage(msg *sarama.ConsumerMessage) (*pb.TPostStats, error) {
+	var statsUpdate pb.TPostStats
+	if err := proto.Unmarshal(msg.Value, &statsUpdate); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal message: %w", err)
+	}
+	if statsUpdate.PostId == 0 {
+		return nil, fmt.Errorf("message has no post id")
+	}
+	return &statsUpdate, nil
+}
+
+// Runs `stats_service dlq inspect [topic]` or `stats_service dlq replay [topic]`, StatsTopic by default. Both go over
+// the messages that were not replayed yet: inspect prints them and replay sends them back to the topic, where they are
+// consumed as usual.
+func RunDLQCommand(args []string) {
+	topic := statsTopic
+	if len(args) == 2 {
+		topic = args[1]
+	}
+	if len(args) < 1 || len(args) > 2 || (args[0] != "inspect" && args[0] != "replay") || dlqMessages[topic] == nil {
+		fmt.Fprintf(os.Stderr, "usage: stats_service dlq inspect|replay [%v|%v|%v]\n", statsTopic, postEventsTopic, pollVotesTopic)
+		os.Exit(2)
+	}
+	replay := args[0] == "replay"
+	dlqTopic := topic + dlqSuffix
+
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	client, err := sarama.NewClient([]string{"kafka:9092"}, config)
+	better_errors.CheckErrorFatal(err, "failed to create kafka client")
+	defer client.Close()
+
+	offsets, err := sarama.NewOffsetManagerFromClient(dlqReplayGroup, client)
+	better_errors.CheckErrorFatal(err, "failed to create offset manager")
+	defer offsets.Close()
+	consumer, err := sarama.NewConsumerFromClient(client)
+	better_errors.CheckErrorFatal(err, "failed to create kafka consumer")
+	defer consumer.Close()
+	var producer sarama.SyncProducer
+	if replay {
+		producer, err = sarama.NewSyncProducerFromClient(client)
+		better_errors.CheckErrorFatal(err, "failed to create kafka producer")
+		defer producer.Close()
+	}
+
+	partitions, err := client.Partitions(dlqTopic)
+	if err != nil {
+		fmt.Printf("%v is empty: %v\n", dlqTopic, err)
+		return
+	}
+	total := 0
+	for _, partition := range partitions {
+		total += processDLQPartition(client, offsets, consumer, producer, topic, partition)
+	}
+	if replay {
+		offsets.Commit()
+		fmt.Printf("Replayed %d messages to %v\n", total, topic)
+	} else {
+		fmt.Printf("%d messages to replay\n", total)
+	}
+}
+
+// Goes over the messages of the partition from the replay offset up to the current end of the partition.
+func processDLQPartition(
+	client sarama.Client,
+	offsets sarama.OffsetManager,
+	consumer sarama.Consumer,
+	producer sarama.SyncProducer,
+	topic string,
+	partition int32,
+) int {
+	dlqTopic := topic + dlqSuffix
+	partitionOffsets, err := offsets.ManagePartition(dlqTopic, partition)
+	better_errors.CheckErrorFatal(err, "failed to get replay offset of partition %d", partition)
+	defer partitionOffsets.Close()
+
+	next, _ := partitionOffsets.NextOffset()
+	end, err := client.GetOffset(dlqTopic, partition, sarama.OffsetNewest)
+	better_errors.CheckErrorFatal(err, "failed to get end of partition %d", partition)
+	if next == sarama.OffsetOldest {
+		next, err = client.GetOffset(dlqTopic, partition, sarama.OffsetOldest)
+		better_errors.CheckErrorFatal(err, "failed to get start of partition %d", partition)
+	}
+	if next >= end {
+		return 0
+	}
+
+	partitionConsumer, err := consumer.ConsumePartition(dlqTopic, partition, next)
+	better_errors.CheckErrorFatal(err, "failed to consume partition %d", partition)
+	defer partitionConsumer.Close()
+
+	count := 0
+	for msg := range partitionConsumer.Messages() {
+		if producer == nil {
+			printDeadLetter(msg, dlqMessages[topic]())
+		} else {
+			_, _, err := producer.SendMessage(replayedMessage(msg, topic))
+			better_errors.CheckErrorFatal(err, "failed to replay message partition %d offset %d", partition, msg.Offset)
+			partitionOffsets.MarkOffset(msg.Offset+1, "")
+		}
+		count++
+		if msg.Offset+1 >= end {
+			break
+		}
+	}
+	return count
+}
+
+func printDeadLetter(msg *sarama.ConsumerMessage, value proto.Message) {
+	fmt.Printf("partition %d offset %d, key %q\n", msg.Partition, msg.Offset, msg.Key)
+	for _, header := range msg.Headers {
+		fmt.Printf("  %s: %s\n", header.Key, header.Value)
+	}
+	if err := proto.Unmarshal(msg.Value, value); err != nil {
+		fmt.Printf("  value (%d bytes): %x\n", len(msg.Value), msg.Value)
+		return
+	}
+	fmt.Printf("  value: %s\n", protojson.Format(value))
+}
+
+// The replayed message gets the original key and headers back, without the ones added by sendToDLQ.
+func replayedMessage(msg *sarama.ConsumerMessage, topic string) *sarama.ProducerMessage {
+	var headers []sarama.RecordHeader
+	for _, header := range msg.Headers {
+		switch string(header.Key) {
+		case dlqErrorHeader, dlqPartitionHeader, dlqOffsetHeader, dlqFailedAtHeader:
+			continue
+		}
+		headers = append(headers, *header)
+	}
+	return &sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: headers,
+	}
+}
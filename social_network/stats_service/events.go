@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"better_errors"
+)
+
+const postEventsTopic = "PostEventsTopic"
+
+// Returned by event handlers for messages that will never be applied, those go to the dead letter topic right away
+var errMalformed = errors.New("malformed message")
+
+type eventHandler func(ctx context.Context, msg *sarama.ConsumerMessage) error
+
+// Applies the events of a topic one by one, committing the offset of every event once it is applied. Events left
+// after a crash are delivered again, so the handlers should be idempotent.
+type eventConsumer struct {
+	topic  string
+	handle eventHandler
+}
+
+// Consumes the topic as a member of the group until ctx is done.
+func ConsumeEvents(ctx context.Context, groupId string, topic string, handle eventHandler) {
+	consumeGroup(ctx, groupId, topic, eventConsumer{topic: topic, handle: handle})
+}
+
+func (c eventConsumer) Setup(session sarama.ConsumerGroupSession) error {
+	log.Printf("Consuming %v partitions %v", c.topic, session.Claims()[c.topic])
+	return nil
+}
+
+func (eventConsumer) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (c eventConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		better_errors.CheckErrorPanic(c.apply(msg), "failed to apply %v message offset %d", c.topic, msg.Offset)
+		session.MarkMessage(msg, "")
+		session.Commit()
+	}
+	return nil
+}
+
+// Retries the event while it fails, like statsBatch.write does. Malformed events and the ones that still fail
+// while ClickHouse is reachable go to the dead letter topic.
+func (c eventConsumer) apply(msg *sarama.ConsumerMessage) error {
+	ctx := context.Background()
+	backoff := retryBackoff
+	for attempt := 1; ; attempt++ {
+		err := c.handle(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		if errors.Is(err, errMalformed) || (attempt >= writeAttempts && db.Ping(ctx) == nil) {
+			return sendToDLQ(msg, err)
+		}
+		log.Printf("Failed to apply %v message offset %d, attempt %d: %v", c.topic, msg.Offset, attempt, err)
+		writeRetries.Add(1)
+		time.Sleep(backoff)
+		backoff = min(2*backoff, maxRetryBackoff)
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	pb "proto"
+)
+
+func (s *server) GetViewerLikes(ctx context.Context, request *pb.TGetViewerLikesRequest) (*pb.TGetViewerLikesResponse, error) {
+	response := &pb.TGetViewerLikesResponse{}
+	if len(request.PostIds) == 0 {
+		return response, nil
+	}
+	rows, err := db.Query(
+		ctx,
+		`SELECT post_id FROM post_likes FINAL WHERE login = ? AND has(?, post_id) AND liked`,
+		request.ViewerLogin,
+		request.PostIds,
+	)
+	if err != nil {
+		log.Printf("failed to get likes of %v: %v", request.ViewerLogin, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postId uint64
+		if err := rows.Scan(&postId); err != nil {
+			log.Printf("error reading row: %v", err)
+			return nil, err
+		}
+		response.LikedPostIds = append(response.LikedPostIds, postId)
+	}
+	if err = rows.Err(); err != nil {
+		log.Printf("error iterating over rows: %v", err)
+		return nil, err
+	}
+	return response, nil
+}
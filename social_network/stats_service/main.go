@@ -2,12 +2,17 @@ package main
 
 import (
 	"context"
+	"expvar"
+	"flag"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
@@ -25,6 +30,10 @@ import (
 
 var (
 	db driver.Conn
+
+	trendingWindow *time.Duration
+	batchSize      *int
+	batchInterval  *time.Duration
 )
 
 type server struct {
@@ -32,10 +41,28 @@ type server struct {
 }
 
 func main() {
-	ConnectClickhouseDB()
+	trendingWindow = flag.Duration("trending_window", 24*time.Hour, "default time window for trending tags")
+	consumerGroup := flag.String("consumer_group", "stats_service", "kafka consumer group shared by the replicas")
+	statsPartitions := flag.Int("stats_partitions", 6, "number of StatsTopic partitions, bounds the number of busy replicas")
+	batchSize = flag.Int("batch_size", 10000, "max number of stats rows written to clickhouse at once")
+	batchInterval = flag.Duration("batch_interval", time.Second, "max time stats wait to be written to clickhouse")
+	flag.Parse()
+
+	if flag.Arg(0) == "dlq" {
+		RunDLQCommand(flag.Args()[1:])
+		return
+	}
 
+	ConnectClickhouseDB()
 	CreateTable()
 
+	if flag.Arg(0) == "aggregates" {
+		RunAggregatesCommand(flag.Args()[1:])
+		return
+	}
+
+	EnsureStatsTopic(int32(*statsPartitions))
+
 	listenAddress := ":50051"
 	lis, err := net.Listen("tcp", listenAddress)
 	if err != nil {
@@ -49,8 +76,20 @@ func main() {
 	r := mux.NewRouter()
 	r.HandleFunc("/health", HealthCheckHandler).Methods("GET")
 	r.HandleFunc("/cheat/{post_id}", GetPostStatsHandler).Methods("GET")
+	r.Handle("/debug/vars", expvar.Handler()).Methods("GET")
 
-	go ConsumeKafka()
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	dlqProducer = NewDLQProducer()
+	defer func() {
+		better_errors.CheckError(dlqProducer.Close(), "failed to close dead letter producer")
+	}()
+	go func() {
+		ConsumeKafka(ctx, *consumerGroup)
+		serverInstance.GracefulStop()
+	}()
+	go ConsumeEvents(ctx, *consumerGroup+".post_events", postEventsTopic, handlePostEvent)
+	go ConsumeEvents(ctx, *consumerGroup+".poll_votes", pollVotesTopic, handlePollVote)
 	go func() {
 		log.Println("Starting stats service on port 8001")
 		log.Panicln(http.ListenAndServe(":8001", r))
@@ -84,15 +123,98 @@ func CreateTable() {
         PRIMARY KEY post_id;
     `)
 	better_errors.CheckErrorFatal(err, "error on creating post_stats")
+	err = db.Exec(context.Background(), `ALTER TABLE post_stats ADD COLUMN IF NOT EXISTS login String`)
+	better_errors.CheckErrorFatal(err, "error on adding login to post_stats")
 	err = db.Exec(context.Background(), `
 		CREATE TABLE IF NOT EXISTS post_author (
 			post_id UInt64,
 			author_login String
-		) engine = MergeTree()
-		ORDER BY post_id
-		PRIMARY KEY post_id;
+		) engine = ReplacingMergeTree()
+		ORDER BY post_id;
     `)
 	better_errors.CheckErrorFatal(err, "error on creating post_author")
+	MigratePostAuthor()
+	err = db.Exec(context.Background(), `
+		CREATE TABLE IF NOT EXISTS post_tags (
+			post_id UInt64,
+			tags Array(String),
+			updated_at DateTime64(3)
+		) engine = ReplacingMergeTree(updated_at)
+		ORDER BY post_id;
+    `)
+	better_errors.CheckErrorFatal(err, "error on creating post_tags")
+	err = db.Exec(context.Background(), `
+		CREATE TABLE IF NOT EXISTS post_visibility (
+			post_id UInt64,
+			visibility String,
+			updated_at DateTime64(3)
+		) engine = ReplacingMergeTree(updated_at)
+		ORDER BY post_id;
+    `)
+	better_errors.CheckErrorFatal(err, "error on creating post_visibility")
+	err = db.Exec(context.Background(), `
+		CREATE TABLE IF NOT EXISTS post_reposts (
+			post_id UInt64,
+			repost_id UInt64,
+			author_login String,
+			quote Bool,
+			timestamp DateTime
+		) engine = MergeTree()
+		ORDER BY (post_id, repost_id);
+    `)
+	better_errors.CheckErrorFatal(err, "error on creating post_reposts")
+	err = db.Exec(context.Background(), `
+		CREATE TABLE IF NOT EXISTS poll_votes (
+			post_id UInt64,
+			login String,
+			option_id UInt32,
+			voted_at DateTime64(3)
+		) engine = ReplacingMergeTree(voted_at)
+		ORDER BY (post_id, login);
+    `)
+	better_errors.CheckErrorFatal(err, "error on creating poll_votes")
+	err = db.Exec(context.Background(), `
+		CREATE TABLE IF NOT EXISTS post_likes (
+			post_id UInt64,
+			login String,
+			liked Bool,
+			updated_at DateTime64(3)
+		) engine = ReplacingMergeTree(updated_at)
+		ORDER BY (post_id, login);
+    `)
+	better_errors.CheckErrorFatal(err, "error on creating post_likes")
+
+	CreateAggregates()
+}
+
+// post_author used to be a MergeTree, where a redelivered PUBLISHED event added a second row. Such a table is swapped
+// for an empty ReplacingMergeTree and its rows are copied over after the swap, so that no insert is lost meanwhile.
+func MigratePostAuthor() {
+	var engine string
+	err := db.QueryRow(
+		context.Background(),
+		`SELECT engine FROM system.tables WHERE database = currentDatabase() AND name = 'post_author'`,
+	).Scan(&engine)
+	better_errors.CheckErrorFatal(err, "error on checking post_author")
+	if engine == "ReplacingMergeTree" {
+		return
+	}
+
+	log.Printf("Migrating post_author from %v to ReplacingMergeTree", engine)
+	err = db.Exec(context.Background(), `
+		CREATE TABLE IF NOT EXISTS post_author_replacing (
+			post_id UInt64,
+			author_login String
+		) engine = ReplacingMergeTree()
+		ORDER BY post_id;
+    `)
+	better_errors.CheckErrorFatal(err, "error on creating post_author_replacing")
+	err = db.Exec(context.Background(), `RENAME TABLE post_author TO post_author_merge_tree, post_author_replacing TO post_author`)
+	better_errors.CheckErrorFatal(err, "error on swapping post_author")
+	err = db.Exec(context.Background(), `INSERT INTO post_author (post_id, author_login) SELECT post_id, author_login FROM post_author_merge_tree`)
+	better_errors.CheckErrorFatal(err, "error on copying post_author")
+	err = db.Exec(context.Background(), `DROP TABLE post_author_merge_tree`)
+	better_errors.CheckErrorFatal(err, "error on dropping post_author_merge_tree")
 }
 
 func HealthCheckHandler(w http.ResponseWriter, r *http.Request) {
@@ -106,14 +228,17 @@ func HealthCheckHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func GetPostStats(postId uint64) (*pb.TGetPostStatsResponse, error) {
-	row := db.QueryRow(context.Background(), fmt.Sprintf(`
+	row := db.QueryRow(context.Background(), `
 		SELECT
-			first_value(post_id) as PostId,
-			sum(viewed) as Views,
-			sum(liked) as Likes
-		FROM post_stats
-		WHERE post_id == %v
-	`, postId))
+			toUInt64(@post_id) as PostId,
+			sum(views) as Views,
+			sum(likes) + (SELECT countIf(liked) FROM post_likes FINAL WHERE post_id == @post_id) as Likes,
+			(SELECT uniqExactIf(repost_id, NOT quote) FROM post_reposts WHERE post_id == @post_id) as Reposts,
+			(SELECT uniqExactIf(repost_id, quote) FROM post_reposts WHERE post_id == @post_id) as Quotes,
+			uniqCombinedMerge(viewers) as UniqueViewers
+		FROM post_stats_hourly
+		WHERE post_id == @post_id
+	`, clickhouse.Named("post_id", postId))
 	stats := &pb.TGetPostStatsResponse{}
 	err := row.ScanStruct(stats)
 	return stats, err
@@ -144,141 +269,135 @@ func GetPostStatsHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func ConsumeKafka() {
-	consumer, err := sarama.NewConsumer([]string{"kafka:9092"}, nil)
-	better_errors.CheckErrorFatal(err, "failed to create kafka consumer")
-	defer func() {
-		better_errors.CheckErrorFatal(consumer.Close(), "failed to close kafka consumer")
-	}()
-
-	partitionConsumer, err := consumer.ConsumePartition("StatsTopic", 0, sarama.OffsetNewest)
-	better_errors.CheckErrorPanic(err, "failed to create a kafka partition consumer")
-	defer func() {
-		better_errors.CheckErrorFatal(partitionConsumer.Close(), "failed to close kafka partition consumer")
-	}()
-
-	consumed := 0
-	for {
-		msg := <-partitionConsumer.Messages()
-		log.Printf("Consumed message offset %d\n", msg.Offset)
-		consumed++
+// Applies an event of PostEventsTopic, applying it again has the same effect.
+func handlePostEvent(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var event pb.TPostEvent
+	if err := proto.Unmarshal(msg.Value, &event); err != nil {
+		return fmt.Errorf("%w: %v", errMalformed, err)
+	}
 
-		var statsUpdate pb.TPostStats
-		err = proto.Unmarshal(msg.Value, &statsUpdate)
-		better_errors.CheckErrorPanic(err, "failed to unmarshal kafka message")
+	switch event.Type {
+	case pb.TPostEvent_DELETED:
+		return DeletePostStats(ctx, event.PostId)
+	case pb.TPostEvent_TAGS_CHANGED:
+		return SetPostTags(ctx, event.PostId, event.Tags)
+	case pb.TPostEvent_PUBLISHED:
+		if err := AddPostAuthor(ctx, event.PostId, event.AuthorLogin); err != nil {
+			return err
+		}
+		return SetPostVisibility(ctx, event.PostId, event.Visibility)
+	case pb.TPostEvent_VISIBILITY_CHANGED:
+		return SetPostVisibility(ctx, event.PostId, event.Visibility)
+	case pb.TPostEvent_REPOSTED, pb.TPostEvent_QUOTED:
+		return AddPostRepost(ctx, event.RefPostId, event.PostId, event.AuthorLogin, event.Type == pb.TPostEvent_QUOTED)
+	}
+	return nil
+}
 
-		InsertIntoClickhouse(&statsUpdate)
+// Drops everything known about a post that was purged from post_service.
+func DeletePostStats(ctx context.Context, postId uint64) error {
+	for _, table := range []string{"post_stats", "post_stats_hourly", "post_author", "post_tags", "post_visibility", "poll_votes", "post_likes"} {
+		if err := db.Exec(ctx, `ALTER TABLE `+table+` DELETE WHERE post_id = ?`, postId); err != nil {
+			return fmt.Errorf("failed to delete %v of post %v: %w", table, postId, err)
+		}
 	}
+	return db.Exec(ctx, `ALTER TABLE post_reposts DELETE WHERE post_id = ? OR repost_id = ?`, postId, postId)
 }
 
-func InsertIntoClickhouse(info *pb.TPostStats) {
-	err := db.AsyncInsert(
-		context.Background(),
-		fmt.Sprintf(`INSERT INTO post_stats VALUES (%v, %v, %v, now())`, info.PostId, info.Viewed, info.Liked),
-		false,
+// Reposts are counted by their ids, so a redelivered event does not count twice.
+func AddPostRepost(ctx context.Context, postId uint64, repostId uint64, authorLogin string, quote bool) error {
+	return db.Exec(
+		ctx,
+		`INSERT INTO post_reposts (post_id, repost_id, author_login, quote, timestamp) VALUES (?, ?, ?, ?, now())`,
+		postId,
+		repostId,
+		authorLogin,
+		quote,
 	)
-	better_errors.CheckErrorPanic(err, "error on executing insert query")
 }
 
-func (s *server) GetTopPosts(ctx context.Context, request *pb.TGetTopPostsRequest) (*pb.TGetTopPostsResponse, error) {
-	query := fmt.Sprintf(`
-	SELECT
-		ps.post_id,
-		pa.author_login,
-		SUM(ps.liked) AS total_likes,
-		SUM(ps.viewed) AS total_views
-	FROM
-	 	post_stats AS ps
-	INNER JOIN
-	 	post_author AS pa
-	ON
-	 	ps.post_id = pa.post_id
-	GROUP BY
-	 	ps.post_id, pa.author_login
-	ORDER BY
-	 	total_%v DESC
-	LIMIT 3;
-   `, request.OrderBy)
-
-	// Execute the query
-	rows, err := db.Query(ctx, query)
-	if err != nil {
-		log.Printf("failed to get top posts: %v", err)
-		return nil, err
+// The latest tag set of a post wins once ReplacingMergeTree collapses the rows.
+func SetPostTags(ctx context.Context, postId uint64, tags []string) error {
+	if tags == nil {
+		tags = []string{}
 	}
-	defer rows.Close()
-
-	// Process the results
-	response := &pb.TGetTopPostsResponse{}
-	for rows.Next() {
-		var postID uint64
-		var totalLikes uint64
-		var totalViews uint64
-		var authorLogin string
-
-		err := rows.Scan(&postID, &authorLogin, &totalLikes, &totalViews)
-		if err != nil {
-			log.Printf("error reading row: %v", err)
-			return nil, err
-		}
+	return db.Exec(ctx, `INSERT INTO post_tags (post_id, tags, updated_at) VALUES (?, ?, now64(3))`, postId, tags)
+}
 
-		response.Posts = append(response.Posts, &pb.TGetTopPostsResponse_TPostStat{
-			PostId:      postID,
-			AuthorLogin: authorLogin,
-			Likes:       totalLikes,
-			Views:       totalViews,
-		})
-	}
+// Posts without a row are the ones published before visibility existed, those are public.
+func SetPostVisibility(ctx context.Context, postId uint64, visibility pb.TPost_EVisibility) error {
+	return db.Exec(ctx, `INSERT INTO post_visibility (post_id, visibility, updated_at) VALUES (?, ?, now64(3))`, postId, visibility.String())
+}
 
-	// Check for any errors encountered during iteration
-	if err = rows.Err(); err != nil {
-		log.Printf("error iterating over rows: %v", err)
-		return nil, err
-	}
+func (s *server) GetPostStats(ctx context.Context, request *pb.TGetPostStatsRequest) (*pb.TGetPostStatsResponse, error) {
+	stats, err := GetPostStats(request.GetPostId())
+	return stats, err
+}
 
-	// Return the response
-	return response, nil
+func (s *server) AddPost(ctx context.Context, request *pb.TAddPostRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, AddPostAuthor(ctx, request.PostId, request.AuthorLogin)
+}
 
+// Posts are added once they are published, either directly or by post_service's PUBLISHED event. A redelivered
+// event adds a row that replaces the previous one, readers use FINAL to see one row per post.
+func AddPostAuthor(ctx context.Context, postId uint64, authorLogin string) error {
+	return db.AsyncInsert(ctx, `INSERT INTO post_author (post_id, author_login) VALUES (?, ?)`, true, postId, authorLogin)
 }
 
-func (s *server) GetTopAuthors(ctx context.Context, _ *emptypb.Empty) (*pb.TGetTopAuthorsResponse, error) {
-	rows, err := db.Query(context.Background(), `
+// Trending tags are shown to everyone, so only public posts count, like in the rankings of a logged out user.
+func (s *server) GetTrendingTags(ctx context.Context, request *pb.TGetTrendingTagsRequest) (*pb.TGetTrendingTagsResponse, error) {
+	window := *trendingWindow
+	if request.WindowSeconds != 0 {
+		window = time.Duration(request.WindowSeconds) * time.Second
+	}
+	limit := request.Limit
+	if limit == 0 {
+		limit = 10
+	}
+
+	rows, err := db.Query(ctx, `
 		SELECT
-			pa.author_login,
-			COALESCE(SUM(ps.liked), 0) AS total_likes
+			pt.tag,
+			SUM(ps.viewed) AS total_views,
+			SUM(ps.liked) AS total_likes
 		FROM
-			post_stats AS ps
+			(
+				SELECT post_id, viewed, liked FROM post_stats WHERE timestamp >= now() - toIntervalSecond(?)
+				UNION ALL
+				SELECT post_id, toUInt64(0) AS viewed, toUInt64(1) AS liked FROM post_likes FINAL
+				WHERE liked AND updated_at >= now() - toIntervalSecond(?)
+			) AS ps
 		INNER JOIN
-			post_author AS pa
+			(SELECT post_id, arrayJoin(tags) AS tag FROM post_tags FINAL) AS pt
 		ON
-			ps.post_id = pa.post_id
+			ps.post_id = pt.post_id
+		LEFT JOIN
+			(SELECT post_id, visibility FROM post_visibility FINAL) AS pv
+		ON
+			ps.post_id = pv.post_id
+		WHERE
+			pv.visibility IN ('', 'PUBLIC')
 		GROUP BY
-			pa.author_login
+			pt.tag
 		ORDER BY
-			total_likes DESC
-		LIMIT 3;
-	`)
+			total_likes + total_views DESC
+		LIMIT ?;
+	`, uint64(window.Seconds()), uint64(window.Seconds()), limit)
 	if err != nil {
-		log.Printf("failed to get top authors: %v", err)
+		log.Printf("failed to get trending tags: %v", err)
 		return nil, err
 	}
 	defer rows.Close()
 
-	response := &pb.TGetTopAuthorsResponse{}
+	response := &pb.TGetTrendingTagsResponse{}
 	for rows.Next() {
-		var authorLogin string
-		var totalLikes uint64
-
-		err := rows.Scan(&authorLogin, &totalLikes)
+		tag := &pb.TGetTrendingTagsResponse_TTag{}
+		err := rows.Scan(&tag.Tag, &tag.Views, &tag.Likes)
 		if err != nil {
 			log.Printf("error reading row: %v", err)
 			return nil, err
 		}
-
-		response.Authors = append(response.Authors, &pb.TGetTopAuthorsResponse_TAuthor{
-			AuthorLogin: authorLogin,
-			Likes:       totalLikes,
-		})
+		response.Tags = append(response.Tags, tag)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -287,19 +406,4 @@ func (s *server) GetTopAuthors(ctx context.Context, _ *emptypb.Empty) (*pb.TGetT
 	}
 
 	return response, nil
-
-}
-
-func (s *server) GetPostStats(ctx context.Context, request *pb.TGetPostStatsRequest) (*pb.TGetPostStatsResponse, error) {
-	stats, err := GetPostStats(request.GetPostId())
-	return stats, err
-}
-
-func (s *server) AddPost(ctx context.Context, request *pb.TAddPostRequest) (*emptypb.Empty, error) {
-	err := db.AsyncInsert(
-		ctx,
-		fmt.Sprintf(`INSERT INTO post_author (post_id, author_login) VALUES (%d, '%s')`, request.PostId, request.AuthorLogin),
-		true,
-	)
-	return &emptypb.Empty{}, err
 }
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/sarama"
+	"google.golang.org/protobuf/proto"
+
+	pb "proto"
+)
+
+const pollVotesTopic = "PollVotesTopic"
+
+// Applies a vote of PollVotesTopic, applying it again has the same effect.
+func handlePollVote(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var vote pb.TPollVote
+	if err := proto.Unmarshal(msg.Value, &vote); err != nil {
+		return fmt.Errorf("%w: %v", errMalformed, err)
+	}
+	return InsertPollVote(ctx, &vote)
+}
+
+// Every vote is kept as a row, the latest one of the user wins once ReplacingMergeTree collapses them.
+// Storing the same vote again changes nothing.
+func InsertPollVote(ctx context.Context, vote *pb.TPollVote) error {
+	return db.Exec(
+		ctx,
+		`INSERT INTO poll_votes (post_id, login, option_id, voted_at) VALUES (?, ?, ?, ?)`,
+		vote.PostId,
+		vote.Login,
+		vote.OptionId,
+		vote.VotedAt.AsTime(),
+	)
+}
+
+// Counts the latest vote of every user, merges may not have happened yet, so argMax picks it explicitly.
+func (s *server) GetPollResults(ctx context.Context, request *pb.TGetPollResultsRequest) (*pb.TGetPollResultsResponse, error) {
+	rows, err := db.Query(ctx, `
+		SELECT option_id, count() AS votes
+		FROM (
+			SELECT login, argMax(option_id, voted_at) AS option_id
+			FROM poll_votes
+			WHERE post_id = ?
+			GROUP BY login
+		)
+		GROUP BY option_id
+		ORDER BY option_id`,
+		request.PostId,
+	)
+	if err != nil {
+		log.Printf("failed to get poll results: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := &pb.TGetPollResultsResponse{}
+	for rows.Next() {
+		option := &pb.TGetPollResultsResponse_TOption{}
+		if err := rows.Scan(&option.OptionId, &option.Votes); err != nil {
+			log.Printf("error reading row: %v", err)
+			return nil, err
+		}
+		response.Options = append(response.Options, option)
+		response.TotalVotes += option.Votes
+	}
+	if err = rows.Err(); err != nil {
+		log.Printf("error iterating over rows: %v", err)
+		return nil, err
+	}
+
+	err = db.QueryRow(
+		ctx,
+		`SELECT argMax(option_id, voted_at) FROM poll_votes WHERE post_id = ? AND login = ?`,
+		request.PostId,
+		request.ViewerLogin,
+	).Scan(&response.ViewerOptionId)
+	if err != nil {
+		log.Printf("failed to get poll vote of %v: %v", request.ViewerLogin, err)
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "proto"
+)
+
+// Bounds the size of the response, a day by the minute still fits
+const MaxTimeSeriesBuckets = 1500
+
+var bucketSizes = map[pb.TGetPostTimeSeriesRequest_EBucket]time.Duration{
+	pb.TGetPostTimeSeriesRequest_MINUTE: time.Minute,
+	pb.TGetPostTimeSeriesRequest_HOUR:   time.Hour,
+	pb.TGetPostTimeSeriesRequest_DAY:    24 * time.Hour,
+}
+
+// The range shown when none is given
+var defaultTimeSeriesRanges = map[pb.TGetPostTimeSeriesRequest_EBucket]time.Duration{
+	pb.TGetPostTimeSeriesRequest_MINUTE: time.Hour,
+	pb.TGetPostTimeSeriesRequest_HOUR:   24 * time.Hour,
+	pb.TGetPostTimeSeriesRequest_DAY:    30 * 24 * time.Hour,
+}
+
+// Buckets are counted from the unix epoch, so days are UTC days and the first bucket starts at or before From.
+// Views are read from post_stats, likes are the ones of post_likes still given, at the time they were given.
+func (s *server) GetPostTimeSeries(ctx context.Context, request *pb.TGetPostTimeSeriesRequest) (*pb.TGetPostTimeSeriesResponse, error) {
+	step, ok := bucketSizes[request.Bucket]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown bucket %v", request.Bucket)
+	}
+	to := time.Now()
+	if request.To != nil {
+		to = request.To.AsTime()
+	}
+	from := to.Add(-defaultTimeSeriesRanges[request.Bucket])
+	if request.From != nil {
+		from = request.From.AsTime()
+	}
+	if !from.Before(to) {
+		return nil, status.Errorf(codes.InvalidArgument, "range should start before it ends")
+	}
+	from = from.Truncate(step)
+	if buckets := (to.Sub(from) + step - 1) / step; buckets > MaxTimeSeriesBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "range has %d buckets, at most %d are allowed", buckets, MaxTimeSeriesBuckets)
+	}
+
+	rows, err := db.Query(ctx, `
+	SELECT bucket, sum(v) AS views, sum(l) AS likes
+	FROM (
+		SELECT toUInt64(intDiv(toUnixTimestamp(timestamp), @step) * @step) AS bucket, sum(viewed) AS v, sum(liked) AS l
+		FROM post_stats
+		WHERE post_id = @post_id AND timestamp >= @from AND timestamp < @to
+		GROUP BY bucket
+		UNION ALL
+		SELECT toUInt64(intDiv(toUnixTimestamp(toDateTime(updated_at)), @step) * @step) AS bucket, toUInt64(0) AS v, count() AS l
+		FROM post_likes FINAL
+		WHERE post_id = @post_id AND liked AND updated_at >= @from AND updated_at < @to
+		GROUP BY bucket
+	)
+	GROUP BY bucket
+	`,
+		clickhouse.Named("post_id", request.PostId),
+		clickhouse.Named("step", uint64(step.Seconds())),
+		clickhouse.Named("from", from),
+		clickhouse.Named("to", to),
+	)
+	if err != nil {
+		log.Printf("failed to get time series of post %v: %v", request.PostId, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	counted := map[int64]*pb.TGetPostTimeSeriesResponse_TBucket{}
+	for rows.Next() {
+		var start uint64
+		bucket := &pb.TGetPostTimeSeriesResponse_TBucket{}
+		if err := rows.Scan(&start, &bucket.Views, &bucket.Likes); err != nil {
+			log.Printf("error reading row: %v", err)
+			return nil, err
+		}
+		counted[int64(start)] = bucket
+	}
+	if err = rows.Err(); err != nil {
+		log.Printf("error iterating over rows: %v", err)
+		return nil, err
+	}
+
+	response := &pb.TGetPostTimeSeriesResponse{PostId: request.PostId}
+	for start := from; start.Before(to); start = start.Add(step) {
+		bucket, ok := counted[start.Unix()]
+		if !ok {
+			bucket = &pb.TGetPostTimeSeriesResponse_TBucket{}
+		}
+		bucket.Start = timestamppb.New(start)
+		response.Buckets = append(response.Buckets, bucket)
+	}
+	return response, nil
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "proto"
+)
+
+const (
+	DefaultTopLimit = 3
+	MaxTopLimit     = 100
+)
+
+// Columns the rankings can be ordered by, nothing else gets into ORDER BY
+var topOrderColumns = map[pb.TGetTopPostsRequest_EOrderBy]string{
+	pb.TGetTopPostsRequest_LIKES:           "total_likes",
+	pb.TGetTopPostsRequest_VIEWS:           "total_views",
+	pb.TGetTopPostsRequest_UNIQUE_VIEWERS:  "total_unique_viewers",
+	pb.TGetTopPostsRequest_ENGAGEMENT_RATE: "engagement_rate",
+}
+
+// Views, likes and unique viewers within [@from, @to) grouped by key, an expression over the tables aliased as s,
+// and named name. Whole hours of the window are read from post_stats_hourly and the partial hours at its edges,
+// [@from, @hour_from) and [@hour_to, @to), from post_stats, so the window is exact. Viewer states of both are
+// merged, so a user is counted once per key. Likes counted by post_stats before likes were tied to users are
+// included.
+func metricsSubquery(key string, name string, join string) string {
+	const edges = `((s.timestamp >= @from AND s.timestamp < @hour_from) OR (s.timestamp >= @hour_to AND s.timestamp < @to))`
+	return `(
+	SELECT ` + name + `, sum(v) AS views, sum(l) AS likes, sum(u) AS unique_viewers
+	FROM (
+		SELECT ` + key + ` AS ` + name + `, sum(s.views) AS v, sum(s.likes) AS l, toUInt64(0) AS u
+		FROM post_stats_hourly AS s ` + join + `
+		WHERE s.hour >= @hour_from AND s.hour < @hour_to
+		GROUP BY ` + name + `
+		UNION ALL
+		SELECT ` + key + ` AS ` + name + `, sum(s.viewed) AS v, sum(s.liked) AS l, toUInt64(0) AS u
+		FROM post_stats AS s ` + join + `
+		WHERE ` + edges + `
+		GROUP BY ` + name + `
+		UNION ALL
+		SELECT ` + key + ` AS ` + name + `, toUInt64(0) AS v, count() AS l, toUInt64(0) AS u
+		FROM post_likes AS s FINAL ` + join + `
+		WHERE s.liked AND s.updated_at >= @from AND s.updated_at < @to
+		GROUP BY ` + name + `
+		UNION ALL
+		SELECT ` + name + `, toUInt64(0) AS v, toUInt64(0) AS l, uniqCombinedMerge(viewers) AS u
+		FROM (
+			SELECT ` + key + ` AS ` + name + `, s.viewers AS viewers
+			FROM post_stats_hourly AS s ` + join + `
+			WHERE s.hour >= @hour_from AND s.hour < @hour_to
+			UNION ALL
+			SELECT ` + key + ` AS ` + name + `, uniqCombinedState(s.login) AS viewers
+			FROM post_stats AS s ` + join + `
+			WHERE ` + edges + ` AND s.viewed > 0 AND s.login != ''
+			GROUP BY ` + name + `
+		)
+		GROUP BY ` + name + `
+	)
+	GROUP BY ` + name + `
+)`
+}
+
+// Metrics per post, authors are joined by the ranking.
+var postMetricsSubquery = metricsSubquery("s.post_id", "post_id", "")
+
+// Metrics per author, resolved from post_author when read, so stats that came before the post's PUBLISHED event
+// are counted as well. Unique viewers are the users that viewed any of the author's posts.
+var authorMetricsSubquery = metricsSubquery(
+	"pa.author_login",
+	"author_login",
+	`INNER JOIN (SELECT post_id, author_login FROM post_author FINAL) AS pa ON s.post_id = pa.post_id`,
+)
+
+// The whole hours within [from, to). A window within a single hour has none, it is read from post_stats only.
+func hourBounds(from time.Time, to time.Time) (hourFrom time.Time, hourTo time.Time) {
+	hourFrom, hourTo = from.Truncate(time.Hour), to.Truncate(time.Hour)
+	if hourFrom.Before(from) {
+		hourFrom = hourFrom.Add(time.Hour)
+	}
+	if hourTo.Before(hourFrom) {
+		return to, to
+	}
+	return hourFrom, hourTo
+}
+
+// Resolves the window to the range [from, to). Stats are timestamped by main_service, so an open end is a bit
+// in the future to let in the stats of a slightly faster clock.
+func windowBounds(window *pb.TTimeWindow) (from time.Time, to time.Time, err error) {
+	now := time.Now()
+	from, to = time.Unix(0, 0), now.Add(time.Minute)
+	if window.GetFrom() != nil || window.GetTo() != nil {
+		if window.GetFrom() != nil {
+			from = window.From.AsTime()
+		}
+		if window.GetTo() != nil {
+			to = window.To.AsTime()
+		}
+		if !from.Before(to) {
+			return from, to, status.Errorf(codes.InvalidArgument, "window should start before it ends")
+		}
+		return from, to, nil
+	}
+	switch window.GetPreset() {
+	case pb.TTimeWindow_LAST_HOUR:
+		from = now.Add(-time.Hour)
+	case pb.TTimeWindow_LAST_DAY:
+		from = now.Add(-24 * time.Hour)
+	case pb.TTimeWindow_LAST_WEEK:
+		from = now.Add(-7 * 24 * time.Hour)
+	}
+	return from, to, nil
+}
+
+// Checks the ranking parameters shared by posts and authors and binds them as named parameters.
+func topParams(orderBy pb.TGetTopPostsRequest_EOrderBy, limit uint32, window *pb.TTimeWindow, pageId uint64) (orderColumn string, params []any, err error) {
+	orderColumn, ok := topOrderColumns[orderBy]
+	if !ok {
+		return "", nil, status.Errorf(codes.InvalidArgument, "unknown order %v", orderBy)
+	}
+	if limit == 0 {
+		limit = DefaultTopLimit
+	}
+	if limit > MaxTopLimit {
+		return "", nil, status.Errorf(codes.InvalidArgument, "limit should be at most %d", MaxTopLimit)
+	}
+	from, to, err := windowBounds(window)
+	if err != nil {
+		return "", nil, err
+	}
+	hourFrom, hourTo := hourBounds(from, to)
+	params = []any{
+		clickhouse.Named("from", from),
+		clickhouse.Named("to", to),
+		clickhouse.Named("hour_from", hourFrom),
+		clickhouse.Named("hour_to", hourTo),
+		clickhouse.Named("limit", limit),
+		clickhouse.Named("offset", pageId*uint64(limit)),
+	}
+	return orderColumn, params, nil
+}
+
+func (s *server) GetTopPosts(ctx context.Context, request *pb.TGetTopPostsRequest) (*pb.TGetTopPostsResponse, error) {
+	orderColumn, params, err := topParams(request.OrderBy, request.Limit, request.Window, request.PageId)
+	if err != nil {
+		return nil, err
+	}
+	followees := request.Followees
+	if followees == nil {
+		followees = []string{}
+	}
+	params = append(params, clickhouse.Named("viewer", request.ViewerLogin), clickhouse.Named("followees", followees))
+
+	rows, err := db.Query(ctx, `
+	SELECT
+		pm.post_id,
+		pa.author_login,
+		pm.likes AS total_likes,
+		pm.views AS total_views,
+		pm.unique_viewers AS total_unique_viewers,
+		if(pm.views = 0, 0, pm.likes / pm.views) AS engagement_rate
+	FROM
+		`+postMetricsSubquery+` AS pm
+	INNER JOIN
+		(SELECT post_id, author_login FROM post_author FINAL) AS pa
+	ON
+		pm.post_id = pa.post_id
+	LEFT JOIN
+		(SELECT post_id, visibility FROM post_visibility FINAL) AS pv
+	ON
+		pm.post_id = pv.post_id
+	WHERE
+		pv.visibility IN ('', 'PUBLIC')
+		OR pa.author_login = @viewer
+		OR (pv.visibility = 'FOLLOWERS' AND has(@followees, pa.author_login))
+	ORDER BY
+		`+orderColumn+` DESC, pm.post_id DESC
+	LIMIT @limit OFFSET @offset
+	`, params...)
+	if err != nil {
+		log.Printf("failed to get top posts: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := &pb.TGetTopPostsResponse{}
+	for rows.Next() {
+		post := &pb.TGetTopPostsResponse_TPostStat{}
+		err := rows.Scan(&post.PostId, &post.AuthorLogin, &post.Likes, &post.Views, &post.UniqueViewers, &post.EngagementRate)
+		if err != nil {
+			log.Printf("error reading row: %v", err)
+			return nil, err
+		}
+		response.Posts = append(response.Posts, post)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error iterating over rows: %v", err)
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *server) GetTopAuthors(ctx context.Context, request *pb.TGetTopAuthorsRequest) (*pb.TGetTopAuthorsResponse, error) {
+	orderColumn, params, err := topParams(request.OrderBy, request.Limit, request.Window, request.PageId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(ctx, `
+	SELECT
+		author_login,
+		likes AS total_likes,
+		views AS total_views,
+		unique_viewers AS total_unique_viewers,
+		if(views = 0, 0, likes / views) AS engagement_rate
+	FROM
+		`+authorMetricsSubquery+`
+	ORDER BY
+		`+orderColumn+` DESC, author_login
+	LIMIT @limit OFFSET @offset
+	`, params...)
+	if err != nil {
+		log.Printf("failed to get top authors: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := &pb.TGetTopAuthorsResponse{}
+	for rows.Next() {
+		author := &pb.TGetTopAuthorsResponse_TAuthor{}
+		err := rows.Scan(&author.AuthorLogin, &author.Likes, &author.Views, &author.UniqueViewers, &author.EngagementRate)
+		if err != nil {
+			log.Printf("error reading row: %v", err)
+			return nil, err
+		}
+		response.Authors = append(response.Authors, author)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error iterating over rows: %v", err)
+		return nil, err
+	}
+	return response, nil
+}
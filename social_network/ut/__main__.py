@@ -4,6 +4,10 @@ from enum import Enum
 import uuid
 import json
 import time
+import datetime
+import struct
+import zlib
+import urllib.parse
 
 class Handles(Enum):
     REGISTER = 1
@@ -20,6 +24,8 @@ class Handles(Enum):
     TOP_LIKED = 12
     TOP_AUTHORS = 13
     POST_STATS = 14
+    POST_REVISIONS = 15
+    POST_TRASH = 16
 
 
 def pprint_response(r: requests.Response):
@@ -50,7 +56,9 @@ class TestSocialNetworkMethods(unittest.TestCase):
             Handles.TOP_LIKED: self.host + "posts/top/liked",
             Handles.TOP_VIEWED: self.host + "posts/top/viewed",
             Handles.TOP_AUTHORS: self.host + "users/top",
-            Handles.POST_STATS: self.host + "posts/stats/"
+            Handles.POST_STATS: self.host + "posts/stats/",
+            Handles.POST_REVISIONS: self.host + "posts/revisions/",
+            Handles.POST_TRASH: self.host + "posts/trash",
         }
         self.login = uuid.uuid4().hex[:7].upper()
         self.password = uuid.uuid4().hex[:7].upper()
@@ -131,7 +139,8 @@ class TestSocialNetworkMethods(unittest.TestCase):
             "PostId": postId,
             "Title": "post#0 (updated)",
             "Content": "brand new data",
-            "AuthorLogin": self.login
+            "AuthorLogin": self.login,
+            "ExpectedVersion": int(r.json()["Post"]["Version"]),
         }
         r = requests.put(self.addrs[Handles.POST_UPDATE], data=json.dumps(data), cookies=cookies.get_dict())
         pprint_response(r)
@@ -161,19 +170,1027 @@ class TestSocialNetworkMethods(unittest.TestCase):
         self.assertIn("post#0 (updated)", r.text)
         self.assertIn("post#2", r.text)
 
+    def test_revisions(self):
+        cookies = self.try_login()
+
+        data = {
+            "Title": "revised",
+            "Content": "first line\nsecond line",
+        }
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+
+        data = {
+            "PostId": postId,
+            "Title": "revised",
+            "Content": "first line\nchanged line",
+            "ExpectedVersion": 1,
+        }
+        r = requests.put(self.addrs[Handles.POST_UPDATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        r = requests.get(self.addrs[Handles.POST_REVISIONS] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        revisions = r.json()["Revisions"]
+        self.assertEqual(len(revisions), 2)
+        first, second = revisions[0]["RevisionId"], revisions[1]["RevisionId"]
+
+        r = requests.get(self.addrs[Handles.POST_REVISIONS] + f"{postId}/diff?from={first}&to={second}", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        ops = {(line.get("Op", "EQUAL"), line["Text"]) for line in r.json()["Content"]}
+        self.assertIn(("DELETE", "second line"), ops)
+        self.assertIn(("INSERT", "changed line"), ops)
+
+        r = requests.post(self.addrs[Handles.POST_REVISIONS] + f"{postId}/{first}/restore", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertIn("second line", r.text)
+
+    def test_revisions_diff_limit(self):
+        cookies = self.try_login()
+
+        def diff(before: str, after: str):
+            data = {"Title": "long", "Content": before}
+            r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+            self.assertEqual(r.status_code, 200)
+            postId = int(r.json()["PostId"])
+            data = {"PostId": postId, "Title": "long", "Content": after, "ExpectedVersion": 1}
+            r = requests.put(self.addrs[Handles.POST_UPDATE], data=json.dumps(data), cookies=cookies.get_dict())
+            self.assertEqual(r.status_code, 200)
+            r = requests.get(self.addrs[Handles.POST_REVISIONS] + str(postId), cookies=cookies.get_dict())
+            first, second = [revision["RevisionId"] for revision in r.json()["Revisions"]]
+            return requests.get(self.addrs[Handles.POST_REVISIONS] + f"{postId}/diff?from={first}&to={second}", cookies=cookies.get_dict())
+
+        # lines shared around the edit are not counted
+        common = "\n".join(f"line {i}" for i in range(5000))
+        r = diff(common + "\nold\n" + common, common + "\nnew\n" + common)
+        self.assertEqual(r.status_code, 200)
+        ops = [line.get("Op", "EQUAL") for line in r.json()["Content"]]
+        self.assertEqual(ops.count("DELETE"), 1)
+        self.assertEqual(ops.count("INSERT"), 1)
+
+        r = diff("\n".join(f"a{i}" for i in range(2001)), "\n".join(f"b{i}" for i in range(2001)))
+        pprint_response(r)
+        self.assertEqual(r.status_code, 400)
+
+    def test_concurrent_update(self):
+        cookies = self.try_login()
+
+        data = {
+            "Title": "contested",
+            "Content": "original",
+        }
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        etag = r.headers["ETag"]
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict(), headers={"If-None-Match": etag})
+        pprint_response(r)
+        self.assertEqual(r.status_code, 304)
+
+        # likes do not change the version, but they change what the viewer sees
+        self.like_post(postId)
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict(), headers={"If-None-Match": etag})
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        self.assertTrue(r.json()["Post"].get("LikedByViewer", False))
+        self.assertNotEqual(r.headers["ETag"], etag)
+
+        data = {"PostId": postId, "Title": "contested", "Content": "first editor"}
+        r = requests.put(self.addrs[Handles.POST_UPDATE], data=json.dumps(data), cookies=cookies.get_dict(), headers={"If-Match": etag})
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        self.assertNotEqual(r.headers["ETag"], etag)
+
+        data = {"PostId": postId, "Title": "contested", "Content": "second editor"}
+        r = requests.put(self.addrs[Handles.POST_UPDATE], data=json.dumps(data), cookies=cookies.get_dict(), headers={"If-Match": etag})
+        pprint_response(r)
+        self.assertEqual(r.status_code, 412)
+
+    def test_patch(self):
+        cookies = self.try_login()
+
+        data = {
+            "Title": "patched",
+            "Content": "content survives",
+        }
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+
+        headers = {"If-Match": '"1"', "Content-Type": "application/merge-patch+json"}
+        r = requests.patch(self.host + f"posts/{postId}", data=json.dumps({"Title": "patched title"}), cookies=cookies.get_dict(), headers=headers)
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertIn("patched title", r.text)
+        self.assertIn("content survives", r.text)
+
+        headers["If-Match"] = r.headers["ETag"]
+        r = requests.patch(self.host + f"posts/{postId}", data=json.dumps({"AuthorLogin": "someone"}), cookies=cookies.get_dict(), headers=headers)
+        pprint_response(r)
+        self.assertEqual(r.status_code, 400)
+
+    def test_comments(self):
+        cookies = self.try_login()
+
+        data = {
+            "Title": "discussed",
+            "Content": "let's talk",
+        }
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+        commentsUrl = self.host + f"posts/{postId}/comments"
+
+        commentIds = []
+        for i in range(3):
+            r = requests.post(commentsUrl, data=json.dumps({"Content": f"comment#{i}"}), cookies=cookies.get_dict())
+            pprint_response(r)
+            self.assertEqual(r.status_code, 200)
+            commentIds.append(int(r.json()["Comment"]["CommentId"]))
+
+        r = requests.post(commentsUrl, data=json.dumps({"Content": "reply", "ParentId": commentIds[0]}), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        r = requests.put(self.host + f"comments/liked/{commentIds[1]}", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        r = requests.get(commentsUrl + "?order=top&limit=2", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        page = r.json()
+        self.assertEqual(len(page["Comments"]), 2)
+        self.assertEqual(int(page["Comments"][0]["CommentId"]), commentIds[1])
+
+        r = requests.get(commentsUrl + "?order=top&limit=2&cursor=" + page["NextCursor"], cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(len(r.json()["Comments"]), 1)
+
+        r = requests.get(commentsUrl + f"?parent={commentIds[0]}", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertIn("reply", r.text)
+
+        r = requests.delete(self.host + f"comments/{commentIds[0]}", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        # comments of posts the user can not see look missing
+        data = {"Title": "private discussion", "Content": "just me", "Visibility": "PRIVATE"}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        privateId = int(r.json()["PostId"])
+        r = requests.post(self.host + f"posts/{privateId}/comments", data=json.dumps({"Content": "note"}), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        privateCommentId = int(r.json()["Comment"]["CommentId"])
+
+        other = requests.Session()
+        credentials = {"login": uuid.uuid4().hex[:7], "password": "secret"}
+        other.post(self.addrs[Handles.REGISTER], data=json.dumps(credentials))
+        r = other.put(self.host + f"comments/liked/{privateCommentId}")
+        self.assertEqual(r.status_code, 404)
+        r = other.delete(self.host + f"comments/liked/{privateCommentId}")
+        self.assertEqual(r.status_code, 404)
+        r = other.put(self.host + f"comments/{privateCommentId}", data=json.dumps({"Content": "edited"}))
+        self.assertEqual(r.status_code, 404)
+        r = other.delete(self.host + f"comments/{privateCommentId}")
+        self.assertEqual(r.status_code, 404)
+
+        # comments of trashed posts can not be changed even by their authors
+        r = requests.delete(self.addrs[Handles.POST_DELETE] + str(privateId), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        r = requests.put(self.host + f"comments/liked/{privateCommentId}", cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 404)
+        r = requests.put(self.host + f"comments/{privateCommentId}", data=json.dumps({"Content": "edited"}), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 404)
+
+    def test_tags(self):
+        cookies = self.try_login()
+
+        tag = "tag" + uuid.uuid4().hex[:7]
+        data = {
+            "Title": "tagged",
+            "Content": f"about #{tag.upper()} and #{tag}",
+        }
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.json()["Post"]["Tags"], [tag])
+
+        r = requests.get(self.host + f"tags/{tag}/posts", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        self.assertEqual([int(p["PostId"]) for p in r.json()["Posts"]], [postId])
+
+        # tags of private posts never trend
+        privateTag = "tag" + uuid.uuid4().hex[:7]
+        data = {"Title": "private tag", "Content": f"#{privateTag}", "Visibility": "PRIVATE"}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        privateId = int(r.json()["PostId"])
+
+        self.view_post(privateId)
+        self.view_post(postId)
+        r = requests.get(self.host + "tags/trending?window=1h&limit=100", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        self.assertIn(tag, r.text)
+        self.assertNotIn(privateTag, r.text)
+
+    def test_search(self):
+        cookies = self.try_login()
+
+        word = "word" + uuid.uuid4().hex[:7]
+        data = {
+            "Title": "searchable",
+            "Content": f"the quick brown {word} jumps over the lazy dog",
+        }
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+
+        for q in [word, word[:8] + "*", f'"brown {word} jumps"']:
+            r = requests.get(self.host + "posts/search", params={"q": q, "author": self.login}, cookies=cookies.get_dict())
+            pprint_response(r)
+            self.assertEqual(r.status_code, 200)
+            hits = r.json()["Hits"]
+            self.assertEqual([int(hit["Post"]["PostId"]) for hit in hits], [postId])
+            self.assertIn(f"<b>{word}</b>", hits[0]["ContentHighlight"])
+
+        r = requests.get(self.host + "posts/search", params={"q": f"{word} -dog"}, cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.json().get("Hits", []), [])
+
+        # markup of the post is escaped, only the matches are highlighted
+        markup = "markup" + uuid.uuid4().hex[:7]
+        data = {"Title": "<i>markup</i>", "Content": f'<img src=x onerror="alert(1)"> {markup} <script>alert(2)</script>'}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        r = requests.get(self.host + "posts/search", params={"q": markup}, cookies=cookies.get_dict())
+        pprint_response(r)
+        highlight = r.json()["Hits"][0]["ContentHighlight"]
+        self.assertIn(f"<b>{markup}</b>", highlight)
+        self.assertNotIn("<img", highlight)
+        self.assertNotIn("<script", highlight)
+        self.assertNotIn("<i>", r.json()["Hits"][0]["TitleHighlight"])
+
+    def test_attachments(self):
+        cookies = self.try_login()
+
+        data = {
+            "Title": "with attachments",
+            "Content": "see the files",
+        }
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+
+        png = b"\x89PNG\r\n\x1a\n" + b"\x00" * 100
+        r = requests.post(self.host + f"posts/{postId}/attachments", files={"file": ("pic.png", png, "text/plain")}, cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 201)
+        attachment = r.json()["Attachment"]
+        self.assertEqual(attachment["ContentType"], "image/png")
+
+        r = requests.post(self.host + f"posts/{postId}/attachments", files={"file": ("run.exe", b"MZ\x90\x00" + b"\x00" * 100)}, cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 415)
+
+        # resumable upload in two chunks
+        text = b"hello attachments " * 100
+        r = requests.post(self.host + "uploads", data=json.dumps({"postId": postId, "fileName": "notes.txt", "size": len(text)}), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 201)
+        uploadUrl = self.host + r.headers["Location"].lstrip("/")
+
+        r = requests.patch(uploadUrl, data=text[:1000], headers={"Upload-Offset": "0"}, cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 204)
+        r = requests.patch(uploadUrl, data=text[1000:], headers={"Upload-Offset": "0"}, cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 409)
+        r = requests.head(uploadUrl, cookies=cookies.get_dict())
+        self.assertEqual(r.headers["Upload-Offset"], "1000")
+        r = requests.patch(uploadUrl, data=text[1000:], headers={"Upload-Offset": "1000"}, cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 201)
+        textAttachment = r.json()["Attachment"]
+
+        r = requests.get(self.host + f"attachments/{textAttachment['AttachmentId']}", cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        self.assertEqual(r.content, text)
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(len(r.json()["Post"]["Attachments"]), 2)
+
+        r = requests.delete(self.host + f"attachments/{attachment['AttachmentId']}", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        r = requests.get(self.host + f"attachments/{attachment['AttachmentId']}", cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 404)
+
+    def test_image_variants(self):
+        cookies = self.try_login()
+
+        data = {
+            "Title": "with a picture",
+            "Content": "look",
+        }
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+
+        # opaque 600x300 grayscale png
+        def chunk(kind, body):
+            return struct.pack(">I", len(body)) + kind + body + struct.pack(">I", zlib.crc32(kind + body))
+        rows = b"".join(b"\x00" + bytes(x % 256 for x in range(600)) for _ in range(300))
+        png = b"\x89PNG\r\n\x1a\n" + chunk(b"IHDR", struct.pack(">IIBBBBB", 600, 300, 8, 0, 0, 0, 0)) + chunk(b"IDAT", zlib.compress(rows)) + chunk(b"IEND", b"")
+
+        r = requests.post(self.host + f"posts/{postId}/attachments", files={"file": ("pic.png", png)}, cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 201)
+        attachmentId = r.json()["Attachment"]["AttachmentId"]
+
+        for _ in range(10):
+            r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+            attachment = r.json()["Post"]["Attachments"][0]
+            if all(v.get("State") == "READY" for v in attachment["Variants"]):
+                break
+            time.sleep(1)
+        pprint_response(r)
+        variants = {v.get("Name", "ORIGINAL"): v for v in attachment["Variants"]}
+        self.assertEqual((attachment["Width"], attachment["Height"]), (600, 300))
+        self.assertNotEqual(attachment["BlurHash"], "")
+        self.assertEqual((variants["THUMBNAIL"]["Width"], variants["THUMBNAIL"]["Height"]), (256, 128))
+        self.assertEqual(variants["MEDIUM"]["Width"], 600)
+
+        r = requests.get(self.host + f"attachments/{attachmentId}?variant=thumbnail", cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        self.assertEqual(r.headers["Content-Type"], "image/jpeg")
+
+    def test_drafts(self):
+        cookies = self.try_login()
+
+        data = {
+            "Title": "draft_post",
+            "Content": "not ready yet",
+            "Status": "DRAFT",
+        }
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        draftId = int(r.json()["PostId"])
+
+        publishAt = datetime.datetime.now(datetime.timezone.utc) + datetime.timedelta(seconds=3)
+        data = {
+            "Title": "scheduled_post",
+            "Content": "soon",
+            "Status": "SCHEDULED",
+            "PublishAt": publishAt.isoformat().replace("+00:00", "Z"),
+        }
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        scheduledId = int(r.json()["PostId"])
+
+        r = requests.get(self.host + "posts/drafts", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual([int(p["PostId"]) for p in r.json()["Posts"]], [scheduledId, draftId])
+
+        # other users do not see unpublished posts
+        other = requests.Session()
+        credentials = {"login": uuid.uuid4().hex[:7], "password": "secret"}
+        other.post(self.addrs[Handles.REGISTER], data=json.dumps(credentials))
+        r = other.get(self.addrs[Handles.POST_GET] + str(draftId))
+        self.assertEqual(r.status_code, 404)
+        r = requests.get(self.addrs[Handles.POST_GET] + str(draftId), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+
+        r = requests.post(self.host + f"posts/{draftId}/publish", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        r = other.get(self.addrs[Handles.POST_GET] + str(draftId))
+        self.assertEqual(r.status_code, 200)
+        r = requests.post(self.host + f"posts/{draftId}/publish", cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 409)
+
+        # the scheduler runs every 10 seconds by default
+        for _ in range(20):
+            r = other.get(self.addrs[Handles.POST_GET] + str(scheduledId))
+            if r.status_code == 200:
+                break
+            time.sleep(1)
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        r = requests.get(self.host + "posts/drafts", cookies=cookies.get_dict())
+        self.assertEqual(r.json().get("Posts", []), [])
+
+    def test_visibility(self):
+        cookies = self.try_login()
+
+        tag = "vis" + uuid.uuid4().hex[:8]
+        postIds = {}
+        for visibility in ["PUBLIC", "FOLLOWERS", "UNLISTED", "PRIVATE"]:
+            data = {
+                "Title": visibility.lower() + "_post",
+                "Content": f"#{tag}",
+                "Visibility": visibility,
+            }
+            r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+            pprint_response(r)
+            self.assertEqual(r.status_code, 200)
+            postIds[visibility] = int(r.json()["PostId"])
+
+        r = requests.get(self.host + f"tags/{tag}/posts", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(
+            sorted(int(p["PostId"]) for p in r.json()["Posts"]),
+            sorted([postIds["PUBLIC"], postIds["FOLLOWERS"], postIds["PRIVATE"]]),
+        )
+
+        other = requests.Session()
+        credentials = {"login": uuid.uuid4().hex[:7], "password": "secret"}
+        other.post(self.addrs[Handles.REGISTER], data=json.dumps(credentials))
+
+        # hidden posts look exactly like missing ones
+        for visibility, code in [("PUBLIC", 200), ("FOLLOWERS", 404), ("UNLISTED", 200), ("PRIVATE", 404)]:
+            r = other.get(self.addrs[Handles.POST_GET] + str(postIds[visibility]))
+            self.assertEqual(r.status_code, code, visibility)
+        r = other.get(self.host + f"posts/{postIds['PRIVATE']}/comments")
+        self.assertEqual(r.status_code, 404)
+        r = other.get(self.host + f"tags/{tag}/posts")
+        self.assertEqual([int(p["PostId"]) for p in r.json()["Posts"]], [postIds["PUBLIC"]])
+
+        r = other.put(self.host + f"users/followed/{self.login}")
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        r = other.get(self.host + "users/following")
+        self.assertEqual(r.json()["Logins"], [self.login])
+        r = other.get(self.addrs[Handles.POST_GET] + str(postIds["FOLLOWERS"]))
+        self.assertEqual(r.status_code, 200)
+        r = other.get(self.host + f"tags/{tag}/posts")
+        self.assertEqual(sorted(int(p["PostId"]) for p in r.json()["Posts"]), sorted([postIds["PUBLIC"], postIds["FOLLOWERS"]]))
+
+        r = other.delete(self.host + f"users/followed/{self.login}")
+        self.assertEqual(r.status_code, 200)
+        r = other.get(self.addrs[Handles.POST_GET] + str(postIds["FOLLOWERS"]))
+        self.assertEqual(r.status_code, 404)
+        r = other.put(self.host + f"users/followed/{uuid.uuid4().hex}")
+        self.assertEqual(r.status_code, 404)
+
+        # stats of hidden posts are neither shown nor counted
+        for postId in [postIds["PRIVATE"], 2**62]:
+            r = other.get(self.addrs[Handles.POST_STATS] + str(postId))
+            self.assertEqual(r.status_code, 404, postId)
+            r = other.put(self.addrs[Handles.POST_VIEW] + str(postId))
+            self.assertEqual(r.status_code, 404, postId)
+            r = other.put(self.addrs[Handles.POST_LIKE] + str(postId))
+            self.assertEqual(r.status_code, 404, postId)
+            r = other.delete(self.addrs[Handles.POST_LIKE] + str(postId))
+            self.assertEqual(r.status_code, 404, postId)
+        r = requests.get(self.addrs[Handles.POST_STATS] + str(postIds["PRIVATE"]), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+
+        # making the post public reveals it
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postIds["PRIVATE"]), cookies=cookies.get_dict())
+        r = requests.patch(
+            self.host + f"posts/{postIds['PRIVATE']}",
+            data=json.dumps({"Visibility": "PUBLIC"}),
+            headers={"If-Match": r.headers["ETag"]},
+            cookies=cookies.get_dict(),
+        )
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        r = other.get(self.addrs[Handles.POST_GET] + str(postIds["PRIVATE"]))
+        self.assertEqual(r.status_code, 200)
+
+    def test_reposts(self):
+        cookies = self.try_login()
+
+        data = {"Title": "original", "Content": "worth sharing"}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        originalId = int(r.json()["PostId"])
+
+        other = requests.Session()
+        credentials = {"login": uuid.uuid4().hex[:7], "password": "secret"}
+        other.post(self.addrs[Handles.REGISTER], data=json.dumps(credentials))
+
+        r = other.post(self.host + f"posts/{originalId}/repost")
+        pprint_response(r)
+        self.assertEqual(r.status_code, 201)
+        repostId = int(r.json()["PostId"])
+        r = other.post(self.host + f"posts/{originalId}/repost")
+        self.assertEqual(r.status_code, 409)
+
+        data = {"Content": "so true", "Kind": "QUOTE", "RefPostId": repostId}
+        r = other.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data))
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        quoteId = int(r.json()["PostId"])
+
+        # quoting a repost quotes the original post
+        r = other.get(self.addrs[Handles.POST_GET] + str(quoteId))
+        pprint_response(r)
+        reference = r.json()["Post"]["Reference"]
+        self.assertEqual(int(reference["PostId"]), originalId)
+        self.assertEqual(reference["Post"]["Content"], "worth sharing")
+
+        r = requests.get(self.host + "users/notifications", cookies=cookies.get_dict())
+        pprint_response(r)
+        notifications = r.json()["Notifications"]
+        self.assertEqual([n["Type"] for n in notifications[:2]], ["QUOTED", "REPOSTED"])
+        self.assertEqual(notifications[0]["ActorLogin"], credentials["login"])
+
+        for _ in range(10):
+            r = requests.get(self.addrs[Handles.POST_STATS] + str(originalId), cookies=cookies.get_dict())
+            if int(r.json().get("Reposts", 0)) == 1 and int(r.json().get("Quotes", 0)) == 1:
+                break
+            time.sleep(1)
+        pprint_response(r)
+        self.assertEqual(int(r.json().get("Reposts", 0)), 1)
+        self.assertEqual(int(r.json().get("Quotes", 0)), 1)
+
+        # deleted posts turn into tombstones
+        r = requests.delete(self.addrs[Handles.POST_DELETE] + str(originalId), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        r = other.get(self.addrs[Handles.POST_GET] + str(repostId))
+        pprint_response(r)
+        reference = r.json()["Post"]["Reference"]
+        self.assertTrue(reference["Deleted"])
+        self.assertNotIn("Post", reference)
+
+    def test_polls(self):
+        cookies = self.try_login()
+
+        data = {"Title": "poll", "Content": "one option is not enough", "Poll": {"Options": [{"Text": "only"}]}}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 400)
+
+        data = {"Title": "poll", "Content": "tabs or spaces?", "Poll": {"Options": [{"Text": "tabs"}, {"Text": "spaces"}]}}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+        pollUrl = self.host + f"posts/{postId}/poll"
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        self.assertEqual([o["Text"] for o in r.json()["Post"]["Poll"]["Options"]], ["tabs", "spaces"])
+
+        # results are hidden until the user votes
+        r = requests.get(pollUrl, cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertFalse(r.json().get("ResultsVisible", False))
+
+        r = requests.post(pollUrl + "/vote", data=json.dumps({"OptionId": 3}), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 400)
+        # the voter sees the vote right away, before stats_service counts it
+        r = requests.post(pollUrl + "/vote", data=json.dumps({"OptionId": 1}), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 202)
+        r = requests.get(pollUrl, cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertTrue(r.json()["ResultsVisible"])
+        self.assertEqual(r.json()["ViewerOptionId"], 1)
+        self.assertEqual([int(o.get("Votes", 0)) for o in r.json()["Options"]], [1, 0])
+        # changing the vote replaces it
+        r = requests.post(pollUrl + "/vote", data=json.dumps({"OptionId": 2}), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 202)
+        r = requests.get(pollUrl, cookies=cookies.get_dict())
+        self.assertEqual(r.json()["ViewerOptionId"], 2)
+
+        time.sleep(2)
+        r = requests.get(pollUrl, cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.json()["ViewerOptionId"], 2)
+        self.assertTrue(r.json()["ResultsVisible"])
+        self.assertEqual(int(r.json()["TotalVotes"]), 1)
+        self.assertEqual([int(o.get("Votes", 0)) for o in r.json()["Options"]], [0, 1])
+
+    def test_mentions(self):
+        cookies = self.try_login()
+
+        other = requests.Session()
+        credentials = {"login": uuid.uuid4().hex[:7], "password": "secret"}
+        other.post(self.addrs[Handles.REGISTER], data=json.dumps(credentials))
+        mentioned = credentials["login"]
+
+        # unknown users and emails are not mentions
+        data = {"Title": "hello", "Content": f"hi @{mentioned} and @{mentioned}, cc @nobody_{mentioned} at mail@{mentioned}"}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.json()["Post"]["Mentions"], [mentioned])
+
+        r = other.get(self.host + "users/mentions")
+        pprint_response(r)
+        self.assertEqual([int(p["PostId"]) for p in r.json()["Posts"]], [postId])
+
+        r = other.get(self.host + "users/notifications")
+        pprint_response(r)
+        notifications = r.json()["Notifications"]
+        self.assertEqual([n["Type"] for n in notifications], ["MENTIONED"])
+        self.assertEqual(notifications[0]["ActorLogin"], self.login)
+
+        # private posts do not notify the users who can not see them
+        data = {"Title": "secret", "Content": f"@{mentioned} can not read this", "Visibility": "PRIVATE"}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        r = other.get(self.host + "users/mentions")
+        self.assertEqual(len(r.json()["Posts"]), 1)
+
+        # removing the mention removes the post from the listing
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        headers = {"If-Match": r.headers["ETag"], "Content-Type": "application/merge-patch+json"}
+        r = requests.patch(self.host + f"posts/{postId}", data=json.dumps({"Content": "nobody here"}), cookies=cookies.get_dict(), headers=headers)
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        r = other.get(self.host + "users/mentions")
+        pprint_response(r)
+        self.assertNotIn("Posts", r.json())
+
+    def test_likes(self):
+        cookies = self.try_login()
+
+        data = {"Title": "post_to_like_once", "Content": "likes are counted once per user"}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+
+        self.like_post(postId)
+        self.like_post(postId)
+        _, _, likes = self.get_post_stats(postId)
+        self.assertEqual(likes, 1)
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertTrue(r.json()["Post"].get("LikedByViewer", False))
+
+        r = requests.delete(self.addrs[Handles.POST_LIKE] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        time.sleep(2)
+        _, _, likes = self.get_post_stats(postId)
+        self.assertEqual(likes, 0)
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        self.assertFalse(r.json()["Post"].get("LikedByViewer", False))
+
+    def test_unique_viewers(self):
+        cookies = self.try_login()
+
+        data = {"Title": "post_to_view", "Content": "refreshing does not make more viewers"}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+
+        self.view_post(postId)
+        self.view_post(postId)
+        other = requests.Session()
+        credentials = {"login": uuid.uuid4().hex[:7], "password": "secret"}
+        other.post(self.addrs[Handles.REGISTER], data=json.dumps(credentials))
+        r = other.put(self.addrs[Handles.POST_VIEW] + str(postId))
+        self.assertEqual(r.status_code, 200)
+
+        for _ in range(10):
+            r = requests.get(self.addrs[Handles.POST_STATS] + str(postId), cookies=cookies.get_dict())
+            if int(r.json().get("Views", 0)) == 3:
+                break
+            time.sleep(1)
+        pprint_response(r)
+        self.assertEqual(int(r.json().get("Views", 0)), 3)
+        self.assertEqual(int(r.json().get("UniqueViewers", 0)), 2)
+
+        r = requests.get(self.host + "posts/top/unique_viewers", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+    def test_hostile_stats_input(self):
+        # stats_service binds values as parameters, quotes in logins end up as data
+        hostile = {"login": "o'h" + uuid.uuid4().hex[:5] + "'); DROP TABLE post_author; --", "password": "secret"}
+        session = requests.Session()
+        r = session.post(self.addrs[Handles.REGISTER], data=json.dumps(hostile))
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        data = {"Title": "hostile", "Content": "nothing to see here"}
+        r = session.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data))
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+        r = session.put(self.addrs[Handles.POST_VIEW] + str(postId))
+        self.assertEqual(r.status_code, 200)
+        r = session.put(self.addrs[Handles.POST_LIKE] + str(postId))
+        self.assertEqual(r.status_code, 200)
+
+        for _ in range(10):
+            r = session.get(self.addrs[Handles.POST_STATS] + str(postId))
+            if int(r.json().get("Likes", 0)) == 1:
+                break
+            time.sleep(1)
+        pprint_response(r)
+        self.assertEqual(int(r.json().get("Views", 0)), 1)
+        self.assertEqual(int(r.json().get("Likes", 0)), 1)
+
+        # rankings still work once the hostile author's stats are stored
+        r = session.get(self.host + "posts/top/likes")
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        r = session.get(self.addrs[Handles.TOP_AUTHORS])
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        # sort orders are enum names, anything else is rejected before reaching stats_service
+        for order in ["likes DESC; DROP TABLE post_stats; --", "likes, (SELECT 1)", "total_likes", "0", "Views'"]:
+            r = session.get(self.host + "posts/top/" + urllib.parse.quote(order, safe=""))
+            pprint_response(r)
+            self.assertEqual(r.status_code, 400, order)
+        for order in ["likes", "VIEWS", "unique_viewers"]:
+            r = session.get(self.host + "posts/top/" + order)
+            self.assertEqual(r.status_code, 200, order)
+
+    def test_top_rankings(self):
+        session = requests.Session()
+        credentials = {"login": uuid.uuid4().hex[:7], "password": "secret"}
+        r = session.post(self.addrs[Handles.REGISTER], data=json.dumps(credentials))
+        self.assertEqual(r.status_code, 200)
+
+        data = {"Title": "ranked", "Content": "liked by everyone who saw it"}
+        r = session.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data))
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+        r = session.put(self.addrs[Handles.POST_VIEW] + str(postId))
+        self.assertEqual(r.status_code, 200)
+        r = session.put(self.addrs[Handles.POST_LIKE] + str(postId))
+        self.assertEqual(r.status_code, 200)
+        time.sleep(2)
+
+        # a post viewed and liked once has the highest possible engagement rate
+        r = session.get(self.host + "posts/top/engagement_rate", params={"window": "hour", "limit": 100})
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        posts = r.json().get("Posts", [])
+        self.assertLessEqual(len(posts), 100)
+        self.assertIn(postId, [int(post["PostId"]) for post in posts])
+        rates = [post.get("EngagementRate", 0) for post in posts]
+        self.assertEqual(rates, sorted(rates, reverse=True))
+
+        r = session.get(self.host + "posts/top/likes", params={"limit": 1, "page": 0})
+        self.assertEqual(r.status_code, 200)
+        self.assertLessEqual(len(r.json().get("Posts", [])), 1)
+
+        # nothing was posted in this range
+        r = session.get(self.host + "posts/top/views", params={"from": "2000-01-01T00:00:00Z", "to": "2000-01-02T00:00:00Z"})
+        self.assertEqual(r.status_code, 200)
+        self.assertEqual(r.json().get("Posts", []), [])
+
+        r = session.get(self.addrs[Handles.TOP_AUTHORS], params={"metric": "views", "window": "day", "limit": 100})
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        self.assertIn(credentials["login"], [author["AuthorLogin"] for author in r.json().get("Authors", [])])
+
+        # windows are exact, the hour of the view is not counted as a whole
+        now = datetime.datetime.now(datetime.timezone.utc)
+        window = {
+            "from": now.isoformat(timespec="seconds"),
+            "to": (now + datetime.timedelta(minutes=1)).isoformat(timespec="seconds"),
+            "limit": 100,
+        }
+        r = session.get(self.host + "posts/top/views", params=window)
+        self.assertEqual(r.status_code, 200)
+        self.assertNotIn(postId, [int(post["PostId"]) for post in r.json().get("Posts", [])])
+        r = session.get(self.addrs[Handles.TOP_AUTHORS], params={"metric": "views", **window})
+        self.assertEqual(r.status_code, 200)
+        self.assertNotIn(credentials["login"], [author["AuthorLogin"] for author in r.json().get("Authors", [])])
+
+        for params in [
+            {"window": "month"},
+            {"limit": 101},
+            {"limit": -1},
+            {"page": "next"},
+            {"from": "yesterday"},
+            {"from": "2000-01-02T00:00:00Z", "to": "2000-01-01T00:00:00Z"},
+        ]:
+            r = session.get(self.host + "posts/top/likes", params=params)
+            self.assertEqual(r.status_code, 400, params)
+        r = session.get(self.addrs[Handles.TOP_AUTHORS], params={"metric": "comments"})
+        self.assertEqual(r.status_code, 400)
+
+    def test_post_time_series(self):
+        cookies = self.try_login()
+        data = {"Title": "charted", "Content": "views over time"}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+        url = self.addrs[Handles.POST_STATS] + str(postId) + "/timeseries"
+
+        r = requests.put(self.addrs[Handles.POST_VIEW] + str(postId), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        self.view_post(postId)
+        self.like_post(postId)
+
+        now = datetime.datetime.now(datetime.timezone.utc)
+        params = {
+            "bucket": "minute",
+            "from": (now - datetime.timedelta(minutes=10)).isoformat(timespec="seconds"),
+            "to": (now + datetime.timedelta(minutes=1)).isoformat(timespec="seconds"),
+        }
+        r = requests.get(url, params=params, cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        buckets = r.json()["Buckets"]
+        # every minute of the range is there, the empty ones as zeros
+        self.assertIn(len(buckets), [11, 12])
+        self.assertEqual(sum(int(bucket.get("Views", 0)) for bucket in buckets), 2)
+        self.assertEqual(sum(int(bucket.get("Likes", 0)) for bucket in buckets), 1)
+        self.assertGreaterEqual(len([bucket for bucket in buckets if "Views" not in bucket]), 9)
+        starts = [bucket["Start"] for bucket in buckets]
+        self.assertEqual(starts, sorted(starts))
+
+        r = requests.get(url, cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        self.assertIn(len(r.json()["Buckets"]), [24, 25])
+        r = requests.get(url, params={"bucket": "day"}, cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        self.assertEqual(sum(int(bucket.get("Views", 0)) for bucket in r.json()["Buckets"]), 2)
+
+        for params in [
+            {"bucket": "week"},
+            {"from": "yesterday"},
+            {"from": "2000-01-02T00:00:00Z", "to": "2000-01-01T00:00:00Z"},
+            {"bucket": "minute", "from": "2000-01-01T00:00:00Z", "to": "2000-02-01T00:00:00Z"},
+        ]:
+            r = requests.get(url, params=params, cookies=cookies.get_dict())
+            self.assertEqual(r.status_code, 400, params)
+
+        # time series of posts the viewer can not see are reported as missing
+        data = {"Title": "private chart", "Content": "only mine", "Visibility": "PRIVATE"}
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        self.assertEqual(r.status_code, 200)
+        privateId = int(r.json()["PostId"])
+        other = requests.Session()
+        credentials = {"login": uuid.uuid4().hex[:7], "password": "secret"}
+        other.post(self.addrs[Handles.REGISTER], data=json.dumps(credentials))
+        for postId in [privateId, 2**62]:
+            r = other.get(self.addrs[Handles.POST_STATS] + str(postId) + "/timeseries")
+            self.assertEqual(r.status_code, 404, postId)
+        r = other.get(url)
+        self.assertEqual(r.status_code, 200)
+
+    def test_author_analytics(self):
+        author = requests.Session()
+        credentials = {"login": uuid.uuid4().hex[:7], "password": "secret"}
+        r = author.post(self.addrs[Handles.REGISTER], data=json.dumps(credentials))
+        self.assertEqual(r.status_code, 200)
+        url = self.host + "users/me/analytics"
+
+        # a new author has nothing to show yet
+        r = author.get(url)
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        self.assertEqual(r.json().get("TopPosts", []), [])
+        self.assertEqual(int(r.json()["Window"].get("Views", 0)), 0)
+
+        postIds = []
+        for title in ["liked", "only viewed"]:
+            r = author.post(self.addrs[Handles.POST_CREATE], data=json.dumps({"Title": title, "Content": "analytics"}))
+            self.assertEqual(r.status_code, 200)
+            postIds.append(int(r.json()["PostId"]))
+        follower = requests.Session()
+        follower.post(self.addrs[Handles.REGISTER], data=json.dumps({"login": uuid.uuid4().hex[:7], "password": "secret"}))
+        for postId in postIds:
+            r = follower.put(self.addrs[Handles.POST_VIEW] + str(postId))
+            self.assertEqual(r.status_code, 200)
+        r = author.put(self.addrs[Handles.POST_VIEW] + str(postIds[1]))
+        self.assertEqual(r.status_code, 200)
+        r = follower.put(self.addrs[Handles.POST_LIKE] + str(postIds[0]))
+        self.assertEqual(r.status_code, 200)
+        r = follower.put(self.host + "users/followed/" + credentials["login"])
+        self.assertEqual(r.status_code, 200)
+        time.sleep(2)
+
+        r = author.get(url, params={"window": "day"})
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        analytics = r.json()
+        self.assertEqual(analytics["AuthorLogin"], credentials["login"])
+        for totals in [analytics["AllTime"], analytics["Window"]]:
+            self.assertEqual(int(totals.get("Views", 0)), 3)
+            self.assertEqual(int(totals.get("Likes", 0)), 1)
+            self.assertEqual(int(totals.get("UniqueViewers", 0)), 2)
+            self.assertAlmostEqual(totals.get("EngagementRate", 0), 1 / 3)
+        self.assertEqual(int(analytics["PreviousWindow"].get("Views", 0)), 0)
+        self.assertEqual(int(analytics["Change"].get("Views", 0)), 3)
+        self.assertEqual(int(analytics["Change"].get("Likes", 0)), 1)
+        self.assertEqual([int(post["PostId"]) for post in analytics["TopPosts"]], postIds)
+        self.assertEqual(int(analytics["Followers"].get("Followers", 0)), 1)
+        self.assertEqual(int(analytics["Followers"].get("Gained", 0)), 1)
+
+        r = author.get(url, params={"metric": "views", "limit": 1})
+        self.assertEqual(r.status_code, 200)
+        self.assertEqual([int(post["PostId"]) for post in r.json()["TopPosts"]], [postIds[1]])
+
+        # stats of other authors are not mixed in
+        r = follower.get(url)
+        self.assertEqual(r.status_code, 200)
+        self.assertEqual(int(r.json()["AllTime"].get("Views", 0)), 0)
+
+        for params in [{"window": "month"}, {"metric": "comments"}, {"limit": 101}]:
+            r = author.get(url, params=params)
+            self.assertEqual(r.status_code, 400, params)
+
+    def test_trash(self):
+        cookies = self.try_login()
+
+        data = {
+            "Title": "post_to_trash",
+            "Content": "soon deleted",
+        }
+        r = requests.post(self.addrs[Handles.POST_CREATE], data=json.dumps(data), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        postId = int(r.json()["PostId"])
+        self.view_post(postId)
+        self.like_post(postId)
+
+        def ranked():
+            r = requests.get(self.host + "posts/top/engagement_rate", params={"window": "hour", "limit": 100}, cookies=cookies.get_dict())
+            self.assertEqual(r.status_code, 200)
+            return [int(post["PostId"]) for post in r.json().get("Posts", [])]
+
+        self.assertIn(postId, ranked())
+
+        r = requests.delete(self.addrs[Handles.POST_DELETE] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertNotEqual(r.status_code, 200)
+
+        # trashed posts leave the rankings once stats_service hears of it
+        time.sleep(2)
+        self.assertNotIn(postId, ranked())
+
+        r = requests.get(self.addrs[Handles.POST_TRASH], cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+        self.assertIn("post_to_trash", r.text)
+
+        r = requests.post(self.host + f"posts/{postId}/restore", cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        r = requests.get(self.addrs[Handles.POST_GET] + str(postId), cookies=cookies.get_dict())
+        pprint_response(r)
+        self.assertEqual(r.status_code, 200)
+
+        time.sleep(2)
+        self.assertIn(postId, ranked())
+
     def view_post(self, postId: int):
         cookies = self.try_login()
         r = requests.put(self.addrs[Handles.POST_VIEW] + str(postId), cookies=cookies.get_dict())
         pprint_response(r)
         self.assertEqual(r.status_code, 200)
-        time.sleep(1)
+        # stats_service writes stats in batches once a second
+        time.sleep(2)
 
     def like_post(self, postId: int):
         cookies = self.try_login()
         r = requests.put(self.addrs[Handles.POST_LIKE] + str(postId), cookies=cookies.get_dict())
         pprint_response(r)
         self.assertEqual(r.status_code, 200)
-        time.sleep(1)
+        # stats_service writes stats in batches once a second
+        time.sleep(2)
 
     def get_post_stats(self, postId: int):
         cookies = self.try_login()